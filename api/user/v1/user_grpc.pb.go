@@ -19,27 +19,59 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	User_OpenCardHandle_FullMethodName      = "/api.user.v1.User/OpenCardHandle"
-	User_OpenCardTwoHandle_FullMethodName   = "/api.user.v1.User/OpenCardTwoHandle"
-	User_CardStatusHandle_FullMethodName    = "/api.user.v1.User/CardStatusHandle"
-	User_CardStatusHandleTwo_FullMethodName = "/api.user.v1.User/CardStatusHandleTwo"
-	User_Deposit_FullMethodName             = "/api.user.v1.User/Deposit"
-	User_AdminWithdrawEth_FullMethodName    = "/api.user.v1.User/AdminWithdrawEth"
-	User_RewardCardTwo_FullMethodName       = "/api.user.v1.User/RewardCardTwo"
-	User_AdminRewardList_FullMethodName     = "/api.user.v1.User/AdminRewardList"
-	User_AdminUserList_FullMethodName       = "/api.user.v1.User/AdminUserList"
-	User_AdminLogin_FullMethodName          = "/api.user.v1.User/AdminLogin"
-	User_UpdateCanVip_FullMethodName        = "/api.user.v1.User/UpdateCanVip"
-	User_SetVipThree_FullMethodName         = "/api.user.v1.User/SetVipThree"
-	User_SetUserCount_FullMethodName        = "/api.user.v1.User/SetUserCount"
-	User_AdminConfig_FullMethodName         = "/api.user.v1.User/AdminConfig"
-	User_AdminConfigUpdate_FullMethodName   = "/api.user.v1.User/AdminConfigUpdate"
+	User_LoginNonce_FullMethodName                = "/api.user.v1.User/LoginNonce"
+	User_Login_FullMethodName                     = "/api.user.v1.User/Login"
+	User_Register_FullMethodName                  = "/api.user.v1.User/Register"
+	User_Transfer_FullMethodName                  = "/api.user.v1.User/Transfer"
+	User_Withdraw_FullMethodName                  = "/api.user.v1.User/Withdraw"
+	User_WithdrawList_FullMethodName              = "/api.user.v1.User/WithdrawList"
+	User_OpenCardHandle_FullMethodName            = "/api.user.v1.User/OpenCardHandle"
+	User_OpenCardTwoHandle_FullMethodName         = "/api.user.v1.User/OpenCardTwoHandle"
+	User_CardStatusHandle_FullMethodName          = "/api.user.v1.User/CardStatusHandle"
+	User_CardStatusHandleTwo_FullMethodName       = "/api.user.v1.User/CardStatusHandleTwo"
+	User_Deposit_FullMethodName                   = "/api.user.v1.User/Deposit"
+	User_AdminWithdrawEth_FullMethodName          = "/api.user.v1.User/AdminWithdrawEth"
+	User_AdminWithdrawReceipt_FullMethodName      = "/api.user.v1.User/AdminWithdrawReceipt"
+	User_AdminReconcile_FullMethodName            = "/api.user.v1.User/AdminReconcile"
+	User_RewardCardTwo_FullMethodName             = "/api.user.v1.User/RewardCardTwo"
+	User_AdminRewardList_FullMethodName           = "/api.user.v1.User/AdminRewardList"
+	User_AdminUserList_FullMethodName             = "/api.user.v1.User/AdminUserList"
+	User_AdminLogin_FullMethodName                = "/api.user.v1.User/AdminLogin"
+	User_UpdateCanVip_FullMethodName              = "/api.user.v1.User/UpdateCanVip"
+	User_SetVipThree_FullMethodName               = "/api.user.v1.User/SetVipThree"
+	User_SetUserCount_FullMethodName              = "/api.user.v1.User/SetUserCount"
+	User_AdminConfig_FullMethodName               = "/api.user.v1.User/AdminConfig"
+	User_AdminConfigUpdate_FullMethodName         = "/api.user.v1.User/AdminConfigUpdate"
+	User_AdminCardOrderList_FullMethodName        = "/api.user.v1.User/AdminCardOrderList"
+	User_AdminCardOrderHandle_FullMethodName      = "/api.user.v1.User/AdminCardOrderHandle"
+	User_AdminRecomputeTotalAmount_FullMethodName = "/api.user.v1.User/AdminRecomputeTotalAmount"
+	User_AdminDepositPendingList_FullMethodName   = "/api.user.v1.User/AdminDepositPendingList"
+	User_AdminDepositPendingHandle_FullMethodName = "/api.user.v1.User/AdminDepositPendingHandle"
+	User_AdminWithdrawList_FullMethodName         = "/api.user.v1.User/AdminWithdrawList"
+	User_AdminWithdrawHandle_FullMethodName       = "/api.user.v1.User/AdminWithdrawHandle"
+	User_AdminLedgerVerify_FullMethodName         = "/api.user.v1.User/AdminLedgerVerify"
+	User_AdminLedgerOpen_FullMethodName           = "/api.user.v1.User/AdminLedgerOpen"
+	User_AdminHotWallet_FullMethodName            = "/api.user.v1.User/AdminHotWallet"
+	User_AdminReconcileList_FullMethodName        = "/api.user.v1.User/AdminReconcileList"
+	User_AdminReconcileEntries_FullMethodName     = "/api.user.v1.User/AdminReconcileEntries"
 )
 
 // UserClient is the client API for User service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserClient interface {
+	// 钱包登录，先取待签名消息
+	LoginNonce(ctx context.Context, in *LoginNonceRequest, opts ...grpc.CallOption) (*LoginNonceReply, error)
+	// 钱包登录，personal_sign 签名后换取token
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error)
+	// 钱包注册，签名流程同登录，绑定邀请人
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterReply, error)
+	// 用户间划转
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferReply, error)
+	// 用户提现申请
+	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error)
+	// 用户提现记录
+	WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...grpc.CallOption) (*WithdrawListReply, error)
 	// 开卡
 	OpenCardHandle(ctx context.Context, in *OpenCardHandleRequest, opts ...grpc.CallOption) (*OpenCardHandleReply, error)
 	OpenCardTwoHandle(ctx context.Context, in *OpenCardHandleRequest, opts ...grpc.CallOption) (*OpenCardHandleReply, error)
@@ -47,6 +79,10 @@ type UserClient interface {
 	CardStatusHandleTwo(ctx context.Context, in *CardStatusHandleRequest, opts ...grpc.CallOption) (*CardStatusHandleReply, error)
 	Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositReply, error)
 	AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthRequest, opts ...grpc.CallOption) (*AdminWithdrawEthReply, error)
+	// 提现交易回执
+	AdminWithdrawReceipt(ctx context.Context, in *AdminWithdrawReceiptRequest, opts ...grpc.CallOption) (*AdminWithdrawReceiptReply, error)
+	// 余额对账，按流水重算余额，差异写入对账表；需要后台token
+	AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...grpc.CallOption) (*AdminReconcileReply, error)
 	RewardCardTwo(ctx context.Context, in *RewardCardTwoRequest, opts ...grpc.CallOption) (*RewardCardTwoReply, error)
 	AdminRewardList(ctx context.Context, in *AdminRewardListRequest, opts ...grpc.CallOption) (*AdminRewardListReply, error)
 	AdminUserList(ctx context.Context, in *AdminUserListRequest, opts ...grpc.CallOption) (*AdminUserListReply, error)
@@ -56,6 +92,27 @@ type UserClient interface {
 	SetUserCount(ctx context.Context, in *SetUserCountRequest, opts ...grpc.CallOption) (*SetUserCountReply, error)
 	AdminConfig(ctx context.Context, in *AdminConfigRequest, opts ...grpc.CallOption) (*AdminConfigReply, error)
 	AdminConfigUpdate(ctx context.Context, in *AdminConfigUpdateRequest, opts ...grpc.CallOption) (*AdminConfigUpdateReply, error)
+	// 开卡死信队列
+	AdminCardOrderList(ctx context.Context, in *AdminCardOrderListRequest, opts ...grpc.CallOption) (*AdminCardOrderListReply, error)
+	AdminCardOrderHandle(ctx context.Context, in *AdminCardOrderHandleRequest, opts ...grpc.CallOption) (*AdminCardOrderHandleReply, error)
+	// 按充值记录重算业绩
+	AdminRecomputeTotalAmount(ctx context.Context, in *AdminRecomputeTotalAmountRequest, opts ...grpc.CallOption) (*AdminRecomputeTotalAmountReply, error)
+	// 待处理充值
+	AdminDepositPendingList(ctx context.Context, in *AdminDepositPendingListRequest, opts ...grpc.CallOption) (*AdminDepositPendingListReply, error)
+	AdminDepositPendingHandle(ctx context.Context, in *AdminDepositPendingHandleRequest, opts ...grpc.CallOption) (*AdminDepositPendingHandleReply, error)
+	// 提现审核
+	AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...grpc.CallOption) (*AdminWithdrawListReply, error)
+	AdminWithdrawHandle(ctx context.Context, in *AdminWithdrawHandleRequest, opts ...grpc.CallOption) (*AdminWithdrawHandleReply, error)
+	// 余额对账
+	AdminLedgerVerify(ctx context.Context, in *AdminLedgerVerifyRequest, opts ...grpc.CallOption) (*AdminLedgerVerifyReply, error)
+	// 账本期初
+	AdminLedgerOpen(ctx context.Context, in *AdminLedgerOpenRequest, opts ...grpc.CallOption) (*AdminLedgerOpenReply, error)
+	// 热钱包余额
+	AdminHotWallet(ctx context.Context, in *AdminHotWalletRequest, opts ...grpc.CallOption) (*AdminHotWalletReply, error)
+	// 对账差异
+	AdminReconcileList(ctx context.Context, in *AdminReconcileListRequest, opts ...grpc.CallOption) (*AdminReconcileListReply, error)
+	// 对账差异用户的流水明细
+	AdminReconcileEntries(ctx context.Context, in *AdminReconcileEntriesRequest, opts ...grpc.CallOption) (*AdminReconcileEntriesReply, error)
 }
 
 type userClient struct {
@@ -66,6 +123,60 @@ func NewUserClient(cc grpc.ClientConnInterface) UserClient {
 	return &userClient{cc}
 }
 
+func (c *userClient) LoginNonce(ctx context.Context, in *LoginNonceRequest, opts ...grpc.CallOption) (*LoginNonceReply, error) {
+	out := new(LoginNonceReply)
+	err := c.cc.Invoke(ctx, User_LoginNonce_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginReply, error) {
+	out := new(LoginReply)
+	err := c.cc.Invoke(ctx, User_Login_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterReply, error) {
+	out := new(RegisterReply)
+	err := c.cc.Invoke(ctx, User_Register_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferReply, error) {
+	out := new(TransferReply)
+	err := c.cc.Invoke(ctx, User_Transfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...grpc.CallOption) (*WithdrawReply, error) {
+	out := new(WithdrawReply)
+	err := c.cc.Invoke(ctx, User_Withdraw_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...grpc.CallOption) (*WithdrawListReply, error) {
+	out := new(WithdrawListReply)
+	err := c.cc.Invoke(ctx, User_WithdrawList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) OpenCardHandle(ctx context.Context, in *OpenCardHandleRequest, opts ...grpc.CallOption) (*OpenCardHandleReply, error) {
 	out := new(OpenCardHandleReply)
 	err := c.cc.Invoke(ctx, User_OpenCardHandle_FullMethodName, in, out, opts...)
@@ -120,6 +231,24 @@ func (c *userClient) AdminWithdrawEth(ctx context.Context, in *AdminWithdrawEthR
 	return out, nil
 }
 
+func (c *userClient) AdminWithdrawReceipt(ctx context.Context, in *AdminWithdrawReceiptRequest, opts ...grpc.CallOption) (*AdminWithdrawReceiptReply, error) {
+	out := new(AdminWithdrawReceiptReply)
+	err := c.cc.Invoke(ctx, User_AdminWithdrawReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...grpc.CallOption) (*AdminReconcileReply, error) {
+	out := new(AdminReconcileReply)
+	err := c.cc.Invoke(ctx, User_AdminReconcile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) RewardCardTwo(ctx context.Context, in *RewardCardTwoRequest, opts ...grpc.CallOption) (*RewardCardTwoReply, error) {
 	out := new(RewardCardTwoReply)
 	err := c.cc.Invoke(ctx, User_RewardCardTwo_FullMethodName, in, out, opts...)
@@ -201,10 +330,130 @@ func (c *userClient) AdminConfigUpdate(ctx context.Context, in *AdminConfigUpdat
 	return out, nil
 }
 
+func (c *userClient) AdminCardOrderList(ctx context.Context, in *AdminCardOrderListRequest, opts ...grpc.CallOption) (*AdminCardOrderListReply, error) {
+	out := new(AdminCardOrderListReply)
+	err := c.cc.Invoke(ctx, User_AdminCardOrderList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminCardOrderHandle(ctx context.Context, in *AdminCardOrderHandleRequest, opts ...grpc.CallOption) (*AdminCardOrderHandleReply, error) {
+	out := new(AdminCardOrderHandleReply)
+	err := c.cc.Invoke(ctx, User_AdminCardOrderHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminRecomputeTotalAmount(ctx context.Context, in *AdminRecomputeTotalAmountRequest, opts ...grpc.CallOption) (*AdminRecomputeTotalAmountReply, error) {
+	out := new(AdminRecomputeTotalAmountReply)
+	err := c.cc.Invoke(ctx, User_AdminRecomputeTotalAmount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminDepositPendingList(ctx context.Context, in *AdminDepositPendingListRequest, opts ...grpc.CallOption) (*AdminDepositPendingListReply, error) {
+	out := new(AdminDepositPendingListReply)
+	err := c.cc.Invoke(ctx, User_AdminDepositPendingList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminDepositPendingHandle(ctx context.Context, in *AdminDepositPendingHandleRequest, opts ...grpc.CallOption) (*AdminDepositPendingHandleReply, error) {
+	out := new(AdminDepositPendingHandleReply)
+	err := c.cc.Invoke(ctx, User_AdminDepositPendingHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...grpc.CallOption) (*AdminWithdrawListReply, error) {
+	out := new(AdminWithdrawListReply)
+	err := c.cc.Invoke(ctx, User_AdminWithdrawList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminWithdrawHandle(ctx context.Context, in *AdminWithdrawHandleRequest, opts ...grpc.CallOption) (*AdminWithdrawHandleReply, error) {
+	out := new(AdminWithdrawHandleReply)
+	err := c.cc.Invoke(ctx, User_AdminWithdrawHandle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminLedgerVerify(ctx context.Context, in *AdminLedgerVerifyRequest, opts ...grpc.CallOption) (*AdminLedgerVerifyReply, error) {
+	out := new(AdminLedgerVerifyReply)
+	err := c.cc.Invoke(ctx, User_AdminLedgerVerify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminLedgerOpen(ctx context.Context, in *AdminLedgerOpenRequest, opts ...grpc.CallOption) (*AdminLedgerOpenReply, error) {
+	out := new(AdminLedgerOpenReply)
+	err := c.cc.Invoke(ctx, User_AdminLedgerOpen_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminHotWallet(ctx context.Context, in *AdminHotWalletRequest, opts ...grpc.CallOption) (*AdminHotWalletReply, error) {
+	out := new(AdminHotWalletReply)
+	err := c.cc.Invoke(ctx, User_AdminHotWallet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminReconcileList(ctx context.Context, in *AdminReconcileListRequest, opts ...grpc.CallOption) (*AdminReconcileListReply, error) {
+	out := new(AdminReconcileListReply)
+	err := c.cc.Invoke(ctx, User_AdminReconcileList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AdminReconcileEntries(ctx context.Context, in *AdminReconcileEntriesRequest, opts ...grpc.CallOption) (*AdminReconcileEntriesReply, error) {
+	out := new(AdminReconcileEntriesReply)
+	err := c.cc.Invoke(ctx, User_AdminReconcileEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility
 type UserServer interface {
+	// 钱包登录，先取待签名消息
+	LoginNonce(context.Context, *LoginNonceRequest) (*LoginNonceReply, error)
+	// 钱包登录，personal_sign 签名后换取token
+	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	// 钱包注册，签名流程同登录，绑定邀请人
+	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
+	// 用户间划转
+	Transfer(context.Context, *TransferRequest) (*TransferReply, error)
+	// 用户提现申请
+	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	// 用户提现记录
+	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 	// 开卡
 	OpenCardHandle(context.Context, *OpenCardHandleRequest) (*OpenCardHandleReply, error)
 	OpenCardTwoHandle(context.Context, *OpenCardHandleRequest) (*OpenCardHandleReply, error)
@@ -212,6 +461,10 @@ type UserServer interface {
 	CardStatusHandleTwo(context.Context, *CardStatusHandleRequest) (*CardStatusHandleReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
+	// 提现交易回执
+	AdminWithdrawReceipt(context.Context, *AdminWithdrawReceiptRequest) (*AdminWithdrawReceiptReply, error)
+	// 余额对账，按流水重算余额，差异写入对账表；需要后台token
+	AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error)
 	RewardCardTwo(context.Context, *RewardCardTwoRequest) (*RewardCardTwoReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
 	AdminUserList(context.Context, *AdminUserListRequest) (*AdminUserListReply, error)
@@ -221,6 +474,27 @@ type UserServer interface {
 	SetUserCount(context.Context, *SetUserCountRequest) (*SetUserCountReply, error)
 	AdminConfig(context.Context, *AdminConfigRequest) (*AdminConfigReply, error)
 	AdminConfigUpdate(context.Context, *AdminConfigUpdateRequest) (*AdminConfigUpdateReply, error)
+	// 开卡死信队列
+	AdminCardOrderList(context.Context, *AdminCardOrderListRequest) (*AdminCardOrderListReply, error)
+	AdminCardOrderHandle(context.Context, *AdminCardOrderHandleRequest) (*AdminCardOrderHandleReply, error)
+	// 按充值记录重算业绩
+	AdminRecomputeTotalAmount(context.Context, *AdminRecomputeTotalAmountRequest) (*AdminRecomputeTotalAmountReply, error)
+	// 待处理充值
+	AdminDepositPendingList(context.Context, *AdminDepositPendingListRequest) (*AdminDepositPendingListReply, error)
+	AdminDepositPendingHandle(context.Context, *AdminDepositPendingHandleRequest) (*AdminDepositPendingHandleReply, error)
+	// 提现审核
+	AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error)
+	AdminWithdrawHandle(context.Context, *AdminWithdrawHandleRequest) (*AdminWithdrawHandleReply, error)
+	// 余额对账
+	AdminLedgerVerify(context.Context, *AdminLedgerVerifyRequest) (*AdminLedgerVerifyReply, error)
+	// 账本期初
+	AdminLedgerOpen(context.Context, *AdminLedgerOpenRequest) (*AdminLedgerOpenReply, error)
+	// 热钱包余额
+	AdminHotWallet(context.Context, *AdminHotWalletRequest) (*AdminHotWalletReply, error)
+	// 对账差异
+	AdminReconcileList(context.Context, *AdminReconcileListRequest) (*AdminReconcileListReply, error)
+	// 对账差异用户的流水明细
+	AdminReconcileEntries(context.Context, *AdminReconcileEntriesRequest) (*AdminReconcileEntriesReply, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -228,6 +502,24 @@ type UserServer interface {
 type UnimplementedUserServer struct {
 }
 
+func (UnimplementedUserServer) LoginNonce(context.Context, *LoginNonceRequest) (*LoginNonceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginNonce not implemented")
+}
+func (UnimplementedUserServer) Login(context.Context, *LoginRequest) (*LoginReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedUserServer) Register(context.Context, *RegisterRequest) (*RegisterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedUserServer) Transfer(context.Context, *TransferRequest) (*TransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedUserServer) Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdraw not implemented")
+}
+func (UnimplementedUserServer) WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawList not implemented")
+}
 func (UnimplementedUserServer) OpenCardHandle(context.Context, *OpenCardHandleRequest) (*OpenCardHandleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenCardHandle not implemented")
 }
@@ -246,6 +538,12 @@ func (UnimplementedUserServer) Deposit(context.Context, *DepositRequest) (*Depos
 func (UnimplementedUserServer) AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawEth not implemented")
 }
+func (UnimplementedUserServer) AdminWithdrawReceipt(context.Context, *AdminWithdrawReceiptRequest) (*AdminWithdrawReceiptReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawReceipt not implemented")
+}
+func (UnimplementedUserServer) AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReconcile not implemented")
+}
 func (UnimplementedUserServer) RewardCardTwo(context.Context, *RewardCardTwoRequest) (*RewardCardTwoReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardCardTwo not implemented")
 }
@@ -273,6 +571,42 @@ func (UnimplementedUserServer) AdminConfig(context.Context, *AdminConfigRequest)
 func (UnimplementedUserServer) AdminConfigUpdate(context.Context, *AdminConfigUpdateRequest) (*AdminConfigUpdateReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminConfigUpdate not implemented")
 }
+func (UnimplementedUserServer) AdminCardOrderList(context.Context, *AdminCardOrderListRequest) (*AdminCardOrderListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardOrderList not implemented")
+}
+func (UnimplementedUserServer) AdminCardOrderHandle(context.Context, *AdminCardOrderHandleRequest) (*AdminCardOrderHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCardOrderHandle not implemented")
+}
+func (UnimplementedUserServer) AdminRecomputeTotalAmount(context.Context, *AdminRecomputeTotalAmountRequest) (*AdminRecomputeTotalAmountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminRecomputeTotalAmount not implemented")
+}
+func (UnimplementedUserServer) AdminDepositPendingList(context.Context, *AdminDepositPendingListRequest) (*AdminDepositPendingListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositPendingList not implemented")
+}
+func (UnimplementedUserServer) AdminDepositPendingHandle(context.Context, *AdminDepositPendingHandleRequest) (*AdminDepositPendingHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDepositPendingHandle not implemented")
+}
+func (UnimplementedUserServer) AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawList not implemented")
+}
+func (UnimplementedUserServer) AdminWithdrawHandle(context.Context, *AdminWithdrawHandleRequest) (*AdminWithdrawHandleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminWithdrawHandle not implemented")
+}
+func (UnimplementedUserServer) AdminLedgerVerify(context.Context, *AdminLedgerVerifyRequest) (*AdminLedgerVerifyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLedgerVerify not implemented")
+}
+func (UnimplementedUserServer) AdminLedgerOpen(context.Context, *AdminLedgerOpenRequest) (*AdminLedgerOpenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLedgerOpen not implemented")
+}
+func (UnimplementedUserServer) AdminHotWallet(context.Context, *AdminHotWalletRequest) (*AdminHotWalletReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminHotWallet not implemented")
+}
+func (UnimplementedUserServer) AdminReconcileList(context.Context, *AdminReconcileListRequest) (*AdminReconcileListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReconcileList not implemented")
+}
+func (UnimplementedUserServer) AdminReconcileEntries(context.Context, *AdminReconcileEntriesRequest) (*AdminReconcileEntriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminReconcileEntries not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
@@ -286,6 +620,114 @@ func RegisterUserServer(s grpc.ServiceRegistrar, srv UserServer) {
 	s.RegisterService(&User_ServiceDesc, srv)
 }
 
+func _User_LoginNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).LoginNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_LoginNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).LoginNonce(ctx, req.(*LoginNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Withdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Withdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Withdraw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Withdraw(ctx, req.(*WithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_WithdrawList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).WithdrawList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_WithdrawList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).WithdrawList(ctx, req.(*WithdrawListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_OpenCardHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OpenCardHandleRequest)
 	if err := dec(in); err != nil {
@@ -394,6 +836,42 @@ func _User_AdminWithdrawEth_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_AdminWithdrawReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminWithdrawReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminWithdrawReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminWithdrawReceipt(ctx, req.(*AdminWithdrawReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminReconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminReconcile(ctx, req.(*AdminReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_RewardCardTwo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RewardCardTwoRequest)
 	if err := dec(in); err != nil {
@@ -556,6 +1034,222 @@ func _User_AdminConfigUpdate_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_AdminCardOrderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardOrderListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardOrderList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardOrderList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardOrderList(ctx, req.(*AdminCardOrderListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminCardOrderHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCardOrderHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminCardOrderHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminCardOrderHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminCardOrderHandle(ctx, req.(*AdminCardOrderHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminRecomputeTotalAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRecomputeTotalAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminRecomputeTotalAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminRecomputeTotalAmount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminRecomputeTotalAmount(ctx, req.(*AdminRecomputeTotalAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminDepositPendingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositPendingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminDepositPendingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminDepositPendingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminDepositPendingList(ctx, req.(*AdminDepositPendingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminDepositPendingHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDepositPendingHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminDepositPendingHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminDepositPendingHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminDepositPendingHandle(ctx, req.(*AdminDepositPendingHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminWithdrawList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminWithdrawList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminWithdrawList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminWithdrawList(ctx, req.(*AdminWithdrawListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminWithdrawHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminWithdrawHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminWithdrawHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminWithdrawHandle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminWithdrawHandle(ctx, req.(*AdminWithdrawHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminLedgerVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLedgerVerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminLedgerVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminLedgerVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminLedgerVerify(ctx, req.(*AdminLedgerVerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminLedgerOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLedgerOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminLedgerOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminLedgerOpen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminLedgerOpen(ctx, req.(*AdminLedgerOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminHotWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminHotWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminHotWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminHotWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminHotWallet(ctx, req.(*AdminHotWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminReconcileList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReconcileListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminReconcileList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminReconcileList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminReconcileList(ctx, req.(*AdminReconcileListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AdminReconcileEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReconcileEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AdminReconcileEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AdminReconcileEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AdminReconcileEntries(ctx, req.(*AdminReconcileEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -563,6 +1257,30 @@ var User_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "api.user.v1.User",
 	HandlerType: (*UserServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LoginNonce",
+			Handler:    _User_LoginNonce_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _User_Login_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _User_Register_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _User_Transfer_Handler,
+		},
+		{
+			MethodName: "Withdraw",
+			Handler:    _User_Withdraw_Handler,
+		},
+		{
+			MethodName: "WithdrawList",
+			Handler:    _User_WithdrawList_Handler,
+		},
 		{
 			MethodName: "OpenCardHandle",
 			Handler:    _User_OpenCardHandle_Handler,
@@ -587,6 +1305,14 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminWithdrawEth",
 			Handler:    _User_AdminWithdrawEth_Handler,
 		},
+		{
+			MethodName: "AdminWithdrawReceipt",
+			Handler:    _User_AdminWithdrawReceipt_Handler,
+		},
+		{
+			MethodName: "AdminReconcile",
+			Handler:    _User_AdminReconcile_Handler,
+		},
 		{
 			MethodName: "RewardCardTwo",
 			Handler:    _User_RewardCardTwo_Handler,
@@ -623,6 +1349,54 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminConfigUpdate",
 			Handler:    _User_AdminConfigUpdate_Handler,
 		},
+		{
+			MethodName: "AdminCardOrderList",
+			Handler:    _User_AdminCardOrderList_Handler,
+		},
+		{
+			MethodName: "AdminCardOrderHandle",
+			Handler:    _User_AdminCardOrderHandle_Handler,
+		},
+		{
+			MethodName: "AdminRecomputeTotalAmount",
+			Handler:    _User_AdminRecomputeTotalAmount_Handler,
+		},
+		{
+			MethodName: "AdminDepositPendingList",
+			Handler:    _User_AdminDepositPendingList_Handler,
+		},
+		{
+			MethodName: "AdminDepositPendingHandle",
+			Handler:    _User_AdminDepositPendingHandle_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawList",
+			Handler:    _User_AdminWithdrawList_Handler,
+		},
+		{
+			MethodName: "AdminWithdrawHandle",
+			Handler:    _User_AdminWithdrawHandle_Handler,
+		},
+		{
+			MethodName: "AdminLedgerVerify",
+			Handler:    _User_AdminLedgerVerify_Handler,
+		},
+		{
+			MethodName: "AdminLedgerOpen",
+			Handler:    _User_AdminLedgerOpen_Handler,
+		},
+		{
+			MethodName: "AdminHotWallet",
+			Handler:    _User_AdminHotWallet_Handler,
+		},
+		{
+			MethodName: "AdminReconcileList",
+			Handler:    _User_AdminReconcileList_Handler,
+		},
+		{
+			MethodName: "AdminReconcileEntries",
+			Handler:    _User_AdminReconcileEntries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/user/v1/user.proto",
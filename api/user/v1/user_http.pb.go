@@ -19,49 +19,114 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserAdminCardOrderHandle = "/api.user.v1.User/AdminCardOrderHandle"
+const OperationUserAdminCardOrderList = "/api.user.v1.User/AdminCardOrderList"
 const OperationUserAdminConfig = "/api.user.v1.User/AdminConfig"
 const OperationUserAdminConfigUpdate = "/api.user.v1.User/AdminConfigUpdate"
+const OperationUserAdminDepositPendingHandle = "/api.user.v1.User/AdminDepositPendingHandle"
+const OperationUserAdminDepositPendingList = "/api.user.v1.User/AdminDepositPendingList"
+const OperationUserAdminHotWallet = "/api.user.v1.User/AdminHotWallet"
+const OperationUserAdminLedgerOpen = "/api.user.v1.User/AdminLedgerOpen"
+const OperationUserAdminLedgerVerify = "/api.user.v1.User/AdminLedgerVerify"
 const OperationUserAdminLogin = "/api.user.v1.User/AdminLogin"
+const OperationUserAdminRecomputeTotalAmount = "/api.user.v1.User/AdminRecomputeTotalAmount"
+const OperationUserAdminReconcile = "/api.user.v1.User/AdminReconcile"
+const OperationUserAdminReconcileEntries = "/api.user.v1.User/AdminReconcileEntries"
+const OperationUserAdminReconcileList = "/api.user.v1.User/AdminReconcileList"
 const OperationUserAdminRewardList = "/api.user.v1.User/AdminRewardList"
 const OperationUserAdminUserList = "/api.user.v1.User/AdminUserList"
 const OperationUserAdminWithdrawEth = "/api.user.v1.User/AdminWithdrawEth"
+const OperationUserAdminWithdrawHandle = "/api.user.v1.User/AdminWithdrawHandle"
+const OperationUserAdminWithdrawList = "/api.user.v1.User/AdminWithdrawList"
+const OperationUserAdminWithdrawReceipt = "/api.user.v1.User/AdminWithdrawReceipt"
 const OperationUserCardStatusHandle = "/api.user.v1.User/CardStatusHandle"
 const OperationUserCardStatusHandleTwo = "/api.user.v1.User/CardStatusHandleTwo"
 const OperationUserDeposit = "/api.user.v1.User/Deposit"
+const OperationUserLogin = "/api.user.v1.User/Login"
+const OperationUserLoginNonce = "/api.user.v1.User/LoginNonce"
 const OperationUserOpenCardHandle = "/api.user.v1.User/OpenCardHandle"
 const OperationUserOpenCardTwoHandle = "/api.user.v1.User/OpenCardTwoHandle"
+const OperationUserRegister = "/api.user.v1.User/Register"
 const OperationUserRewardCardTwo = "/api.user.v1.User/RewardCardTwo"
 const OperationUserSetUserCount = "/api.user.v1.User/SetUserCount"
 const OperationUserSetVipThree = "/api.user.v1.User/SetVipThree"
+const OperationUserTransfer = "/api.user.v1.User/Transfer"
 const OperationUserUpdateCanVip = "/api.user.v1.User/UpdateCanVip"
+const OperationUserWithdraw = "/api.user.v1.User/Withdraw"
+const OperationUserWithdrawList = "/api.user.v1.User/WithdrawList"
 
 type UserHTTPServer interface {
+	AdminCardOrderHandle(context.Context, *AdminCardOrderHandleRequest) (*AdminCardOrderHandleReply, error)
+	// AdminCardOrderList 开卡死信队列
+	AdminCardOrderList(context.Context, *AdminCardOrderListRequest) (*AdminCardOrderListReply, error)
 	AdminConfig(context.Context, *AdminConfigRequest) (*AdminConfigReply, error)
 	AdminConfigUpdate(context.Context, *AdminConfigUpdateRequest) (*AdminConfigUpdateReply, error)
+	AdminDepositPendingHandle(context.Context, *AdminDepositPendingHandleRequest) (*AdminDepositPendingHandleReply, error)
+	// AdminDepositPendingList 待处理充值
+	AdminDepositPendingList(context.Context, *AdminDepositPendingListRequest) (*AdminDepositPendingListReply, error)
+	// AdminHotWallet 热钱包余额
+	AdminHotWallet(context.Context, *AdminHotWalletRequest) (*AdminHotWalletReply, error)
+	// AdminLedgerOpen 账本期初
+	AdminLedgerOpen(context.Context, *AdminLedgerOpenRequest) (*AdminLedgerOpenReply, error)
+	// AdminLedgerVerify 余额对账
+	AdminLedgerVerify(context.Context, *AdminLedgerVerifyRequest) (*AdminLedgerVerifyReply, error)
 	AdminLogin(context.Context, *AdminLoginRequest) (*AdminLoginReply, error)
+	// AdminRecomputeTotalAmount 按充值记录重算业绩
+	AdminRecomputeTotalAmount(context.Context, *AdminRecomputeTotalAmountRequest) (*AdminRecomputeTotalAmountReply, error)
+	// AdminReconcile 余额对账，按流水重算余额，差异写入对账表；需要后台token
+	AdminReconcile(context.Context, *AdminReconcileRequest) (*AdminReconcileReply, error)
+	// AdminReconcileEntries 对账差异用户的流水明细
+	AdminReconcileEntries(context.Context, *AdminReconcileEntriesRequest) (*AdminReconcileEntriesReply, error)
+	// AdminReconcileList 对账差异
+	AdminReconcileList(context.Context, *AdminReconcileListRequest) (*AdminReconcileListReply, error)
 	AdminRewardList(context.Context, *AdminRewardListRequest) (*AdminRewardListReply, error)
 	AdminUserList(context.Context, *AdminUserListRequest) (*AdminUserListReply, error)
 	AdminWithdrawEth(context.Context, *AdminWithdrawEthRequest) (*AdminWithdrawEthReply, error)
+	AdminWithdrawHandle(context.Context, *AdminWithdrawHandleRequest) (*AdminWithdrawHandleReply, error)
+	// AdminWithdrawList 提现审核
+	AdminWithdrawList(context.Context, *AdminWithdrawListRequest) (*AdminWithdrawListReply, error)
+	// AdminWithdrawReceipt 提现交易回执
+	AdminWithdrawReceipt(context.Context, *AdminWithdrawReceiptRequest) (*AdminWithdrawReceiptReply, error)
 	CardStatusHandle(context.Context, *CardStatusHandleRequest) (*CardStatusHandleReply, error)
 	CardStatusHandleTwo(context.Context, *CardStatusHandleRequest) (*CardStatusHandleReply, error)
 	Deposit(context.Context, *DepositRequest) (*DepositReply, error)
+	// Login 钱包登录，personal_sign 签名后换取token
+	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	// LoginNonce 钱包登录，先取待签名消息
+	LoginNonce(context.Context, *LoginNonceRequest) (*LoginNonceReply, error)
 	// OpenCardHandle 开卡
 	OpenCardHandle(context.Context, *OpenCardHandleRequest) (*OpenCardHandleReply, error)
 	OpenCardTwoHandle(context.Context, *OpenCardHandleRequest) (*OpenCardHandleReply, error)
+	// Register 钱包注册，签名流程同登录，绑定邀请人
+	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
 	RewardCardTwo(context.Context, *RewardCardTwoRequest) (*RewardCardTwoReply, error)
 	SetUserCount(context.Context, *SetUserCountRequest) (*SetUserCountReply, error)
 	SetVipThree(context.Context, *SetVipThreeRequest) (*SetVipThreeReply, error)
+	// Transfer 用户间划转
+	Transfer(context.Context, *TransferRequest) (*TransferReply, error)
 	UpdateCanVip(context.Context, *UpdateCanVipRequest) (*UpdateCanVipReply, error)
+	// Withdraw 用户提现申请
+	Withdraw(context.Context, *WithdrawRequest) (*WithdrawReply, error)
+	// WithdrawList 用户提现记录
+	WithdrawList(context.Context, *WithdrawListRequest) (*WithdrawListReply, error)
 }
 
 func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r := s.Route("/")
+	r.GET("/api/app_server/login_nonce", _User_LoginNonce0_HTTP_Handler(srv))
+	r.POST("/api/app_server/login", _User_Login0_HTTP_Handler(srv))
+	r.POST("/api/app_server/register", _User_Register0_HTTP_Handler(srv))
+	r.POST("/api/app_server/transfer", _User_Transfer0_HTTP_Handler(srv))
+	r.POST("/api/app_server/withdraw", _User_Withdraw0_HTTP_Handler(srv))
+	r.GET("/api/app_server/withdraw_list", _User_WithdrawList0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/open_card_handle", _User_OpenCardHandle0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/open_card_handle_two", _User_OpenCardTwoHandle0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/card_status_handle", _User_CardStatusHandle0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/card_status_handle_two_new", _User_CardStatusHandleTwo0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/deposit", _User_Deposit0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/withdraw_eth", _User_AdminWithdrawEth0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_receipt", _User_AdminWithdrawReceipt0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/reconcile", _User_AdminReconcile0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/reward_card_two", _User_RewardCardTwo0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/reward_list", _User_AdminRewardList0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/user_list", _User_AdminUserList0_HTTP_Handler(srv))
@@ -71,6 +136,144 @@ func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r.POST("/api/admin_dhb/set_user_count", _User_SetUserCount0_HTTP_Handler(srv))
 	r.GET("/api/admin_dhb/config", _User_AdminConfig0_HTTP_Handler(srv))
 	r.POST("/api/admin_dhb/config_update", _User_AdminConfigUpdate0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/card_order_list", _User_AdminCardOrderList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/card_order_handle", _User_AdminCardOrderHandle0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/recompute_total_amount", _User_AdminRecomputeTotalAmount0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/deposit_pending_list", _User_AdminDepositPendingList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/deposit_pending_handle", _User_AdminDepositPendingHandle0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/withdraw_list", _User_AdminWithdrawList0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/withdraw_handle", _User_AdminWithdrawHandle0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/ledger_verify", _User_AdminLedgerVerify0_HTTP_Handler(srv))
+	r.POST("/api/admin_dhb/ledger_open", _User_AdminLedgerOpen0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/hot_wallet", _User_AdminHotWallet0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reconcile_list", _User_AdminReconcileList0_HTTP_Handler(srv))
+	r.GET("/api/admin_dhb/reconcile_entries", _User_AdminReconcileEntries0_HTTP_Handler(srv))
+}
+
+func _User_LoginNonce0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginNonceRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserLoginNonce)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginNonce(ctx, req.(*LoginNonceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginNonceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Login0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Login(ctx, req.(*LoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Register0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RegisterRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRegister)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Register(ctx, req.(*RegisterRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RegisterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Transfer0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TransferRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserTransfer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Transfer(ctx, req.(*TransferRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TransferReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_Withdraw0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserWithdraw)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Withdraw(ctx, req.(*WithdrawRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_WithdrawList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in WithdrawListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserWithdrawList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WithdrawList(ctx, req.(*WithdrawListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WithdrawListReply)
+		return ctx.Result(200, reply)
+	}
 }
 
 func _User_OpenCardHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
@@ -187,6 +390,44 @@ func _User_AdminWithdrawEth0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Cont
 	}
 }
 
+func _User_AdminWithdrawReceipt0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawReceiptRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminWithdrawReceipt)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawReceipt(ctx, req.(*AdminWithdrawReceiptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawReceiptReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminReconcile0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReconcileRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminReconcile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReconcile(ctx, req.(*AdminReconcileRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReconcileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _User_RewardCardTwo0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in RewardCardTwoRequest
@@ -373,22 +614,279 @@ func _User_AdminConfigUpdate0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Con
 	}
 }
 
+func _User_AdminCardOrderList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardOrderListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardOrderList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardOrderList(ctx, req.(*AdminCardOrderListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardOrderListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminCardOrderHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCardOrderHandleRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminCardOrderHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCardOrderHandle(ctx, req.(*AdminCardOrderHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCardOrderHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminRecomputeTotalAmount0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRecomputeTotalAmountRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminRecomputeTotalAmount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRecomputeTotalAmount(ctx, req.(*AdminRecomputeTotalAmountRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminRecomputeTotalAmountReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminDepositPendingList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositPendingListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminDepositPendingList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositPendingList(ctx, req.(*AdminDepositPendingListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositPendingListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminDepositPendingHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDepositPendingHandleRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminDepositPendingHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDepositPendingHandle(ctx, req.(*AdminDepositPendingHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDepositPendingHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminWithdrawList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminWithdrawList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawList(ctx, req.(*AdminWithdrawListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminWithdrawHandle0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminWithdrawHandleRequest
+		if err := ctx.Bind(&in.SendBody); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminWithdrawHandle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminWithdrawHandle(ctx, req.(*AdminWithdrawHandleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminWithdrawHandleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminLedgerVerify0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLedgerVerifyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminLedgerVerify)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLedgerVerify(ctx, req.(*AdminLedgerVerifyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLedgerVerifyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminLedgerOpen0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLedgerOpenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminLedgerOpen)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLedgerOpen(ctx, req.(*AdminLedgerOpenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLedgerOpenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminHotWallet0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminHotWalletRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminHotWallet)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminHotWallet(ctx, req.(*AdminHotWalletRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminHotWalletReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminReconcileList0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReconcileListRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminReconcileList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReconcileList(ctx, req.(*AdminReconcileListRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReconcileListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_AdminReconcileEntries0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReconcileEntriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserAdminReconcileEntries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReconcileEntries(ctx, req.(*AdminReconcileEntriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReconcileEntriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserHTTPClient interface {
+	AdminCardOrderHandle(ctx context.Context, req *AdminCardOrderHandleRequest, opts ...http.CallOption) (rsp *AdminCardOrderHandleReply, err error)
+	AdminCardOrderList(ctx context.Context, req *AdminCardOrderListRequest, opts ...http.CallOption) (rsp *AdminCardOrderListReply, err error)
 	AdminConfig(ctx context.Context, req *AdminConfigRequest, opts ...http.CallOption) (rsp *AdminConfigReply, err error)
 	AdminConfigUpdate(ctx context.Context, req *AdminConfigUpdateRequest, opts ...http.CallOption) (rsp *AdminConfigUpdateReply, err error)
+	AdminDepositPendingHandle(ctx context.Context, req *AdminDepositPendingHandleRequest, opts ...http.CallOption) (rsp *AdminDepositPendingHandleReply, err error)
+	AdminDepositPendingList(ctx context.Context, req *AdminDepositPendingListRequest, opts ...http.CallOption) (rsp *AdminDepositPendingListReply, err error)
+	AdminHotWallet(ctx context.Context, req *AdminHotWalletRequest, opts ...http.CallOption) (rsp *AdminHotWalletReply, err error)
+	AdminLedgerOpen(ctx context.Context, req *AdminLedgerOpenRequest, opts ...http.CallOption) (rsp *AdminLedgerOpenReply, err error)
+	AdminLedgerVerify(ctx context.Context, req *AdminLedgerVerifyRequest, opts ...http.CallOption) (rsp *AdminLedgerVerifyReply, err error)
 	AdminLogin(ctx context.Context, req *AdminLoginRequest, opts ...http.CallOption) (rsp *AdminLoginReply, err error)
+	AdminRecomputeTotalAmount(ctx context.Context, req *AdminRecomputeTotalAmountRequest, opts ...http.CallOption) (rsp *AdminRecomputeTotalAmountReply, err error)
+	AdminReconcile(ctx context.Context, req *AdminReconcileRequest, opts ...http.CallOption) (rsp *AdminReconcileReply, err error)
+	AdminReconcileEntries(ctx context.Context, req *AdminReconcileEntriesRequest, opts ...http.CallOption) (rsp *AdminReconcileEntriesReply, err error)
+	AdminReconcileList(ctx context.Context, req *AdminReconcileListRequest, opts ...http.CallOption) (rsp *AdminReconcileListReply, err error)
 	AdminRewardList(ctx context.Context, req *AdminRewardListRequest, opts ...http.CallOption) (rsp *AdminRewardListReply, err error)
 	AdminUserList(ctx context.Context, req *AdminUserListRequest, opts ...http.CallOption) (rsp *AdminUserListReply, err error)
 	AdminWithdrawEth(ctx context.Context, req *AdminWithdrawEthRequest, opts ...http.CallOption) (rsp *AdminWithdrawEthReply, err error)
+	AdminWithdrawHandle(ctx context.Context, req *AdminWithdrawHandleRequest, opts ...http.CallOption) (rsp *AdminWithdrawHandleReply, err error)
+	AdminWithdrawList(ctx context.Context, req *AdminWithdrawListRequest, opts ...http.CallOption) (rsp *AdminWithdrawListReply, err error)
+	AdminWithdrawReceipt(ctx context.Context, req *AdminWithdrawReceiptRequest, opts ...http.CallOption) (rsp *AdminWithdrawReceiptReply, err error)
 	CardStatusHandle(ctx context.Context, req *CardStatusHandleRequest, opts ...http.CallOption) (rsp *CardStatusHandleReply, err error)
 	CardStatusHandleTwo(ctx context.Context, req *CardStatusHandleRequest, opts ...http.CallOption) (rsp *CardStatusHandleReply, err error)
 	Deposit(ctx context.Context, req *DepositRequest, opts ...http.CallOption) (rsp *DepositReply, err error)
+	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	LoginNonce(ctx context.Context, req *LoginNonceRequest, opts ...http.CallOption) (rsp *LoginNonceReply, err error)
 	OpenCardHandle(ctx context.Context, req *OpenCardHandleRequest, opts ...http.CallOption) (rsp *OpenCardHandleReply, err error)
 	OpenCardTwoHandle(ctx context.Context, req *OpenCardHandleRequest, opts ...http.CallOption) (rsp *OpenCardHandleReply, err error)
+	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterReply, err error)
 	RewardCardTwo(ctx context.Context, req *RewardCardTwoRequest, opts ...http.CallOption) (rsp *RewardCardTwoReply, err error)
 	SetUserCount(ctx context.Context, req *SetUserCountRequest, opts ...http.CallOption) (rsp *SetUserCountReply, err error)
 	SetVipThree(ctx context.Context, req *SetVipThreeRequest, opts ...http.CallOption) (rsp *SetVipThreeReply, err error)
+	Transfer(ctx context.Context, req *TransferRequest, opts ...http.CallOption) (rsp *TransferReply, err error)
 	UpdateCanVip(ctx context.Context, req *UpdateCanVipRequest, opts ...http.CallOption) (rsp *UpdateCanVipReply, err error)
+	Withdraw(ctx context.Context, req *WithdrawRequest, opts ...http.CallOption) (rsp *WithdrawReply, err error)
+	WithdrawList(ctx context.Context, req *WithdrawListRequest, opts ...http.CallOption) (rsp *WithdrawListReply, err error)
 }
 
 type UserHTTPClientImpl struct {
@@ -399,6 +897,32 @@ func NewUserHTTPClient(client *http.Client) UserHTTPClient {
 	return &UserHTTPClientImpl{client}
 }
 
+func (c *UserHTTPClientImpl) AdminCardOrderHandle(ctx context.Context, in *AdminCardOrderHandleRequest, opts ...http.CallOption) (*AdminCardOrderHandleReply, error) {
+	var out AdminCardOrderHandleReply
+	pattern := "/api/admin_dhb/card_order_handle"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminCardOrderHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminCardOrderList(ctx context.Context, in *AdminCardOrderListRequest, opts ...http.CallOption) (*AdminCardOrderListReply, error) {
+	var out AdminCardOrderListReply
+	pattern := "/api/admin_dhb/card_order_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminCardOrderList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AdminConfig(ctx context.Context, in *AdminConfigRequest, opts ...http.CallOption) (*AdminConfigReply, error) {
 	var out AdminConfigReply
 	pattern := "/api/admin_dhb/config"
@@ -425,6 +949,71 @@ func (c *UserHTTPClientImpl) AdminConfigUpdate(ctx context.Context, in *AdminCon
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminDepositPendingHandle(ctx context.Context, in *AdminDepositPendingHandleRequest, opts ...http.CallOption) (*AdminDepositPendingHandleReply, error) {
+	var out AdminDepositPendingHandleReply
+	pattern := "/api/admin_dhb/deposit_pending_handle"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminDepositPendingHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminDepositPendingList(ctx context.Context, in *AdminDepositPendingListRequest, opts ...http.CallOption) (*AdminDepositPendingListReply, error) {
+	var out AdminDepositPendingListReply
+	pattern := "/api/admin_dhb/deposit_pending_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminDepositPendingList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminHotWallet(ctx context.Context, in *AdminHotWalletRequest, opts ...http.CallOption) (*AdminHotWalletReply, error) {
+	var out AdminHotWalletReply
+	pattern := "/api/admin_dhb/hot_wallet"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminHotWallet))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminLedgerOpen(ctx context.Context, in *AdminLedgerOpenRequest, opts ...http.CallOption) (*AdminLedgerOpenReply, error) {
+	var out AdminLedgerOpenReply
+	pattern := "/api/admin_dhb/ledger_open"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminLedgerOpen))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminLedgerVerify(ctx context.Context, in *AdminLedgerVerifyRequest, opts ...http.CallOption) (*AdminLedgerVerifyReply, error) {
+	var out AdminLedgerVerifyReply
+	pattern := "/api/admin_dhb/ledger_verify"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminLedgerVerify))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AdminLogin(ctx context.Context, in *AdminLoginRequest, opts ...http.CallOption) (*AdminLoginReply, error) {
 	var out AdminLoginReply
 	pattern := "/api/admin_dhb/login"
@@ -438,6 +1027,58 @@ func (c *UserHTTPClientImpl) AdminLogin(ctx context.Context, in *AdminLoginReque
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminRecomputeTotalAmount(ctx context.Context, in *AdminRecomputeTotalAmountRequest, opts ...http.CallOption) (*AdminRecomputeTotalAmountReply, error) {
+	var out AdminRecomputeTotalAmountReply
+	pattern := "/api/admin_dhb/recompute_total_amount"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminRecomputeTotalAmount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminReconcile(ctx context.Context, in *AdminReconcileRequest, opts ...http.CallOption) (*AdminReconcileReply, error) {
+	var out AdminReconcileReply
+	pattern := "/api/admin_dhb/reconcile"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminReconcile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminReconcileEntries(ctx context.Context, in *AdminReconcileEntriesRequest, opts ...http.CallOption) (*AdminReconcileEntriesReply, error) {
+	var out AdminReconcileEntriesReply
+	pattern := "/api/admin_dhb/reconcile_entries"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminReconcileEntries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminReconcileList(ctx context.Context, in *AdminReconcileListRequest, opts ...http.CallOption) (*AdminReconcileListReply, error) {
+	var out AdminReconcileListReply
+	pattern := "/api/admin_dhb/reconcile_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminReconcileList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) AdminRewardList(ctx context.Context, in *AdminRewardListRequest, opts ...http.CallOption) (*AdminRewardListReply, error) {
 	var out AdminRewardListReply
 	pattern := "/api/admin_dhb/reward_list"
@@ -477,6 +1118,45 @@ func (c *UserHTTPClientImpl) AdminWithdrawEth(ctx context.Context, in *AdminWith
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) AdminWithdrawHandle(ctx context.Context, in *AdminWithdrawHandleRequest, opts ...http.CallOption) (*AdminWithdrawHandleReply, error) {
+	var out AdminWithdrawHandleReply
+	pattern := "/api/admin_dhb/withdraw_handle"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserAdminWithdrawHandle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminWithdrawList(ctx context.Context, in *AdminWithdrawListRequest, opts ...http.CallOption) (*AdminWithdrawListReply, error) {
+	var out AdminWithdrawListReply
+	pattern := "/api/admin_dhb/withdraw_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminWithdrawList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) AdminWithdrawReceipt(ctx context.Context, in *AdminWithdrawReceiptRequest, opts ...http.CallOption) (*AdminWithdrawReceiptReply, error) {
+	var out AdminWithdrawReceiptReply
+	pattern := "/api/admin_dhb/withdraw_receipt"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserAdminWithdrawReceipt))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) CardStatusHandle(ctx context.Context, in *CardStatusHandleRequest, opts ...http.CallOption) (*CardStatusHandleReply, error) {
 	var out CardStatusHandleReply
 	pattern := "/api/admin_dhb/card_status_handle"
@@ -516,6 +1196,32 @@ func (c *UserHTTPClientImpl) Deposit(ctx context.Context, in *DepositRequest, op
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/api/app_server/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) LoginNonce(ctx context.Context, in *LoginNonceRequest, opts ...http.CallOption) (*LoginNonceReply, error) {
+	var out LoginNonceReply
+	pattern := "/api/app_server/login_nonce"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserLoginNonce))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) OpenCardHandle(ctx context.Context, in *OpenCardHandleRequest, opts ...http.CallOption) (*OpenCardHandleReply, error) {
 	var out OpenCardHandleReply
 	pattern := "/api/admin_dhb/open_card_handle"
@@ -542,6 +1248,19 @@ func (c *UserHTTPClientImpl) OpenCardTwoHandle(ctx context.Context, in *OpenCard
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*RegisterReply, error) {
+	var out RegisterReply
+	pattern := "/api/app_server/register"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRegister))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) RewardCardTwo(ctx context.Context, in *RewardCardTwoRequest, opts ...http.CallOption) (*RewardCardTwoReply, error) {
 	var out RewardCardTwoReply
 	pattern := "/api/admin_dhb/reward_card_two"
@@ -581,6 +1300,19 @@ func (c *UserHTTPClientImpl) SetVipThree(ctx context.Context, in *SetVipThreeReq
 	return &out, err
 }
 
+func (c *UserHTTPClientImpl) Transfer(ctx context.Context, in *TransferRequest, opts ...http.CallOption) (*TransferReply, error) {
+	var out TransferReply
+	pattern := "/api/app_server/transfer"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserTransfer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *UserHTTPClientImpl) UpdateCanVip(ctx context.Context, in *UpdateCanVipRequest, opts ...http.CallOption) (*UpdateCanVipReply, error) {
 	var out UpdateCanVipReply
 	pattern := "/api/admin_dhb/set_can_vip"
@@ -593,3 +1325,29 @@ func (c *UserHTTPClientImpl) UpdateCanVip(ctx context.Context, in *UpdateCanVipR
 	}
 	return &out, err
 }
+
+func (c *UserHTTPClientImpl) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...http.CallOption) (*WithdrawReply, error) {
+	var out WithdrawReply
+	pattern := "/api/app_server/withdraw"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserWithdraw))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in.SendBody, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *UserHTTPClientImpl) WithdrawList(ctx context.Context, in *WithdrawListRequest, opts ...http.CallOption) (*WithdrawListReply, error) {
+	var out WithdrawListReply
+	pattern := "/api/app_server/withdraw_list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserWithdrawList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
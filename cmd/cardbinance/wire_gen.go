@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, v []*conf.Chain, signer *conf.Signer, logger log.Logger) (*kratos.App, func(), error) {
 	grpcServer := server.NewGRPCServer(confServer, logger)
 	db := data.NewDB(confData)
 	client := data.NewRedis(confData)
@@ -34,10 +34,22 @@ func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, logg
 	userRepo := data.NewUserRepo(dataData, logger)
 	transaction := data.NewTransaction(dataData)
 	userUseCase := biz.NewUserUseCase(userRepo, transaction, logger)
-	userService := service.NewUserService(userUseCase, logger, auth)
+	chains, cleanup2, err := service.NewChains(v, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	serviceSigner, err := service.NewSigner(signer, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	userService := service.NewUserService(userUseCase, logger, auth, chains, serviceSigner)
 	httpServer := server.NewHTTPServer(confServer, userService, logger)
 	app := newApp(logger, grpcServer, httpServer)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -4,15 +4,19 @@ import (
 	"bytes"
 	pb "cardbinance/api/user/v1"
 	"cardbinance/internal/pkg/middleware/auth"
+	"cardbinance/internal/pkg/money"
 	"context"
 	"crypto/md5"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	jwt2 "github.com/golang-jwt/jwt/v5"
 	"io"
 	"io/ioutil"
+	"math"
+	"math/big"
 	"net/http"
 	"net/url"
 	"sort"
@@ -35,8 +39,8 @@ type User struct {
 	Card            string
 	CardNumber      string
 	CardOrderId     string
-	CardAmount      float64
-	Amount          float64
+	CardAmount      money.Money
+	Amount          money.Money
 	AmountTwo       uint64
 	MyTotalAmount   uint64
 	IsDelete        uint64
@@ -83,21 +87,145 @@ type Config struct {
 	Value   string
 }
 
-type Withdraw struct {
+// LedgerMismatch 余额缓存和账本不一致
+type LedgerMismatch struct {
+	UserId  uint64
+	Address string
+	Amount  money.Money // user.amount
+	Balance money.Money // 账本余额
+}
+
+// 对账流水来源
+const (
+	ReconcileSourceDeposit    = "deposit"     // eth_user_record 充值记录
+	ReconcileSourceReward     = "reward"      // reward 流水
+	ReconcileSourceTransferIn = "transfer_in" // 收款方没有流水的旧划转，按转出方 reward 流水计
+)
+
+// reconcileRewardSign reward 各类型对余额的方向；充值以 eth_user_record 为准，reason 1 不重复计
+var reconcileRewardSign = map[uint64]int64{
+	RewardReasonWithdraw:           -1, // 到账部分
+	RewardReasonOpenCard:           -1,
+	RewardReasonCardRecharge:       -1, // 老数据，当前不再写入
+	RewardReasonTransfer:           -1, // 转出方
+	RewardReasonCardBonus:          1,
+	RewardReasonCardTwoActiveBonus: 1,
+	RewardReasonOpenCardTwo:        -1, // 老数据，当前不再写入
+	RewardReasonCardTwoBonus:       1,
+	RewardReasonWithdrawRefund:     1,
+	RewardReasonWithdrawFee:        -1,
+	RewardReasonTransferIn:         1,
+	RewardReasonTransferFee:        -1,
+	RewardReasonCardTwoRefund:      1,
+	RewardReasonOpenCardRefund:     1,
+}
+
+// ReconcileRewardSign reward 类型对余额的方向，不计入余额的返回false
+func ReconcileRewardSign(reason uint64) (int64, bool) {
+	sign, ok := reconcileRewardSign[reason]
+	return sign, ok
+}
+
+// ReconcileReason 某一来源的合计，带符号
+type ReconcileReason struct {
+	Source string
+	Reason uint64
+	Amount money.Money
+}
+
+// ReconcileDiscrepancy 对账差异
+type ReconcileDiscrepancy struct {
 	ID        uint64
+	RunId     int64
 	UserId    uint64
-	Amount    float64
-	RelAmount float64
-	Status    string
 	Address   string
+	Amount    money.Money // user.amount
+	Expected  money.Money // 流水重算
+	Diff      money.Money // user.amount - 重算
+	Reasons   []*ReconcileReason
 	CreatedAt time.Time
-	UpdatedAt time.Time
+}
+
+// ReconcileEntry 参与对账的单条流水，Amount 带符号，不计入余额的为0
+type ReconcileEntry struct {
+	Source    string
+	ID        uint64
+	Reason    uint64
+	Amount    money.Money
+	Address   string
+	CreatedAt time.Time
+}
+
+type Withdraw struct {
+	ID          uint64
+	UserId      uint64
+	Amount      money.Money
+	RelAmount   money.Money
+	Status      string
+	Address     string
+	Chain       string
+	TxHash      string
+	Nonce       uint64
+	BlockNumber uint64
+	RawTx       string
+	Remark      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// reward 流水类型，和 pb.RewardReason 一致
+const (
+	RewardReasonDeposit            = uint64(pb.RewardReason_REWARD_REASON_DEPOSIT)
+	RewardReasonWithdraw           = uint64(pb.RewardReason_REWARD_REASON_WITHDRAW)
+	RewardReasonOpenCard           = uint64(pb.RewardReason_REWARD_REASON_OPEN_CARD)
+	RewardReasonCardRecharge       = uint64(pb.RewardReason_REWARD_REASON_CARD_RECHARGE)
+	RewardReasonTransfer           = uint64(pb.RewardReason_REWARD_REASON_TRANSFER)
+	RewardReasonCardBonus          = uint64(pb.RewardReason_REWARD_REASON_CARD_BONUS)
+	RewardReasonCardTwoActiveBonus = uint64(pb.RewardReason_REWARD_REASON_CARD_TWO_ACTIVE_BONUS)
+	RewardReasonOpenCardTwo        = uint64(pb.RewardReason_REWARD_REASON_OPEN_CARD_TWO)
+	RewardReasonCardTwoBonus       = uint64(pb.RewardReason_REWARD_REASON_CARD_TWO_BONUS)
+	RewardReasonWithdrawRefund     = uint64(pb.RewardReason_REWARD_REASON_WITHDRAW_REFUND)
+	RewardReasonWithdrawFee        = uint64(pb.RewardReason_REWARD_REASON_WITHDRAW_FEE)
+	RewardReasonTransferIn         = uint64(pb.RewardReason_REWARD_REASON_TRANSFER_IN)
+	RewardReasonTransferFee        = uint64(pb.RewardReason_REWARD_REASON_TRANSFER_FEE)
+	RewardReasonCardTwoRefund      = uint64(pb.RewardReason_REWARD_REASON_CARD_TWO_REFUND)
+	RewardReasonOpenCardRefund     = uint64(pb.RewardReason_REWARD_REASON_OPEN_CARD_REFUND)
+)
+
+// LedgerReasonOpening 账本期初，只记在 ledger_entry，不写 reward
+const LedgerReasonOpening = uint64(pb.RewardReason_REWARD_REASON_UNSPECIFIED)
+
+var rewardReasonLabels = map[uint64]string{
+	RewardReasonDeposit:            "充值",
+	RewardReasonWithdraw:           "提现",
+	RewardReasonOpenCard:           "开虚拟卡",
+	RewardReasonCardRecharge:       "虚拟卡充值",
+	RewardReasonTransfer:           "划转",
+	RewardReasonCardBonus:          "虚拟卡开卡收益",
+	RewardReasonCardTwoActiveBonus: "实体卡激活收益",
+	RewardReasonOpenCardTwo:        "开实体卡",
+	RewardReasonCardTwoBonus:       "实体卡开卡收益",
+	RewardReasonWithdrawRefund:     "提现驳回退款",
+	RewardReasonWithdrawFee:        "提现手续费",
+	RewardReasonTransferIn:         "收到划转",
+	RewardReasonTransferFee:        "划转手续费",
+	RewardReasonCardTwoRefund:      "开实体卡失败退款",
+	RewardReasonOpenCardRefund:     "开虚拟卡失败退款",
+}
+
+// RewardReasonLabel 流水类型文案，未知类型返回编号
+func RewardReasonLabel(reason uint64) string {
+	if label, ok := rewardReasonLabels[reason]; ok {
+		return label
+	}
+
+	return strconv.FormatUint(reason, 10)
 }
 
 type Reward struct {
 	ID        uint64
 	UserId    uint64
-	Amount    float64
+	Amount    money.Money
 	Reason    uint64
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -105,19 +233,90 @@ type Reward struct {
 	One       uint64
 }
 
-type EthUserRecord struct {
-	ID        int64
-	UserId    int64
-	Hash      string
-	Amount    string
-	AmountTwo uint64
-	Last      int64
+type CardOrder struct {
+	ID        uint64
+	UserId    uint64
+	OrderType uint64
+	OrderNo   string
+	Attempts  uint64
+	Status    string
+	Remark    string
+	Deadline  time.Time
 	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// 开卡订单类型，持卡人审核和开卡状态分别计数
+const (
+	CardOrderTypeHolder    uint64 = 1 // 虚拟卡持卡人审核
+	CardOrderTypeCard      uint64 = 2 // 虚拟卡开卡状态
+	CardOrderTypeHolderTwo uint64 = 3 // 实体卡持卡人审核
+	CardOrderTypeCardTwo   uint64 = 4 // 实体卡开卡状态
+)
+
+type EthUserRecord struct {
+	ID         int64
+	UserId     int64
+	Hash       string
+	Amount     string
+	AmountTwo  uint64
+	RelAmount  money.Money
+	Last       int64
+	Chain      string
+	DepositKey string
+	CreatedAt  time.Time
 }
 
+type DepositCheckpoint struct {
+	ID          uint64
+	Name        string
+	BlockNumber uint64
+	BlockHash   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type DepositPending struct {
+	ID         uint64
+	UserId     uint64
+	Address    string
+	Chain      string
+	Hash       string
+	DepositKey string
+	Amount     string
+	AmountTwo  uint64
+	RelAmount  money.Money
+	Last       int64
+	Status     string
+	Remark     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// 链上充值状态
+const (
+	DepositStatusCredited     = "credited"      // 已入账
+	DepositStatusUnknownUser  = "unknown_user"  // 用户不存在，注册后认领
+	DepositStatusBelowMinimum = "below_minimum" // 低于最低充值金额
+	DepositStatusRejected     = "rejected"      // 后台拒绝
+)
+
+// 提现状态，requested -> approved -> doing -> sending -> confirmed/failed，requested/approved可驳回
+const (
+	WithdrawStatusRequested = "requested" // 待审核
+	WithdrawStatusApproved  = "approved"  // 审核通过，待发放
+	WithdrawStatusRejected  = "rejected"  // 驳回，已退回余额
+	WithdrawStatusRewarded  = "rewarded"  // 老数据，未经审核，已迁回requested（sql/009），不再发放
+	WithdrawStatusDoing     = "doing"     // 发放中，签名交易已落库
+	WithdrawStatusSending   = "sending"   // 已广播，等待回执
+	WithdrawStatusConfirmed = "confirmed" // 回执成功
+	WithdrawStatusFailed    = "failed"    // 回执失败
+)
+
 type UserRepo interface {
 	SetNonceByAddress(ctx context.Context, wallet string) (int64, error)
-	GetAndDeleteWalletTimestamp(ctx context.Context, wallet string) (string, error)
+	GetWalletNonce(ctx context.Context, wallet string) (string, error)
+	DeleteWalletNonce(ctx context.Context, wallet string, nonce string) (bool, error)
 	GetConfigByKeys(keys ...string) ([]*Config, error)
 	GetUserByAddress(address string) (*User, error)
 	GetUserByCard(card string) (*User, error)
@@ -133,16 +332,37 @@ type UserRepo interface {
 	GetAllUsers() ([]*User, error)
 	UpdateCard(ctx context.Context, userId uint64, cardOrderId, card string) error
 	UpdateCardTwoNew(ctx context.Context, userId uint64, card string) error
-	UpdateCardNo(ctx context.Context, userId uint64, amount float64) error
-	UpdateCardNoTwo(ctx context.Context, userId uint64, amount float64) error
+	UpdateCardNo(ctx context.Context, userId uint64, amount money.Money) error
+	UpdateCardNoTwo(ctx context.Context, userId uint64, amount money.Money) error
 	UpdateCardSucces(ctx context.Context, userId uint64, cardNum string) error
 	UpdateCardSuccessTwo(ctx context.Context, userId uint64) error
-	CreateCardRecommend(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error
-	CreateCardRecommendNew(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error
-	CreateCardRecommendTwo(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error
+	CreateCardRecommend(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error
+	CreateCardRecommendNew(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error
+	CreateCardRecommendTwo(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error
 	GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error)
-	AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount float64) error
-	Withdraw(ctx context.Context, userId uint64, amount, amountRel float64, address string) error
+	AmountTo(ctx context.Context, userId, toUserId uint64, fromAddress, toAddress string, amount, amountRel money.Money) error
+	GetUserTransferTotal(ctx context.Context, userId uint64, since time.Time) (money.Money, int64, error)
+	Withdraw(ctx context.Context, userId uint64, amount, amountRel money.Money, address string, chain string, status string, remark string) error
+	LockUser(ctx context.Context, userId uint64) (*User, error)
+	LockUserAmount(ctx context.Context, userId uint64) (money.Money, error)
+	GetUserWithdrawTotal(ctx context.Context, userId uint64, since time.Time) (money.Money, int64, error)
+	GetUserWithdrawAddressFirstUse(ctx context.Context, userId uint64, address string) (*time.Time, bool, error)
+	GetWithdrawOutflow(ctx context.Context, since time.Time) (money.Money, error)
+	GetWithdrawById(id uint64) (*Withdraw, error)
+	GetWithdrawPage(b *Pagination, status string, address string) ([]*Withdraw, error, int64)
+	GetWithdrawPageByUserId(b *Pagination, userId uint64) ([]*Withdraw, error, int64)
+	UpdateWithdrawStatus(ctx context.Context, id uint64, fromStatus, status string, remark string) error
+	RefundWithdraw(ctx context.Context, userId uint64, amount, amountRel money.Money, address string) error
+	GetLedgerMismatchPage(b *Pagination) ([]*LedgerMismatch, error, int64)
+	GetUsersWithoutOpening(limit int) ([]*User, error)
+	OpenLedgerUserBalance(ctx context.Context, userId uint64) (bool, error)
+	GetRewardTotalsByUser() (map[uint64]map[uint64]money.Money, error)
+	GetTransferInTotalsByUser() (map[uint64]money.Money, error)
+	GetDepositTotalsByUser() (map[uint64]money.Money, error)
+	CreateReconcileDiscrepancies(ctx context.Context, runId int64, list []*ReconcileDiscrepancy) error
+	GetReconcileLastRunId() (int64, error)
+	GetReconcileDiscrepancyPage(b *Pagination, runId int64) ([]*ReconcileDiscrepancy, error, int64)
+	GetReconcileEntries(userId uint64, address string) ([]*ReconcileEntry, error)
 	GetUserRewardByUserIdPage(ctx context.Context, b *Pagination, userId uint64, reason uint64) ([]*Reward, error, int64)
 	SetVip(ctx context.Context, userId uint64, vip uint64) error
 	GetUsersOpenCard() ([]*User, error)
@@ -150,11 +370,28 @@ type UserRepo interface {
 	GetUsersOpenCardStatusDoing() ([]*User, error)
 	GetUsersOpenCardStatusDoingTwo() ([]*User, error)
 	GetEthUserRecordLast() (int64, error)
+	HasDepositKeyPrefix(prefixes ...string) (bool, error)
+	GetDepositCheckpoint(name string) (*DepositCheckpoint, error)
+	SetDepositCheckpoint(ctx context.Context, name string, blockNumber uint64, blockHash string) error
+	CreateDepositPending(ctx context.Context, d *DepositPending) error
+	GetDepositPendingById(id uint64) (*DepositPending, error)
+	GetRegisteredDepositPendings(status string, limit int) ([]*DepositPending, error)
+	GetDepositPendingsByAddress(address string, status string) ([]*DepositPending, error)
+	GetDepositPendingPage(b *Pagination, status string, address string) ([]*DepositPending, error, int64)
+	UpdateDepositPendingStatus(ctx context.Context, id uint64, fromStatus, status string, userId uint64, remark string) error
 	GetUserByAddresses(Addresses ...string) (map[string]*User, error)
 	GetUserRecommends() ([]*UserRecommend, error)
 	CreateEthUserRecordListByHash(ctx context.Context, r *EthUserRecord) (*EthUserRecord, error)
 	UpdateUserMyTotalAmountAdd(ctx context.Context, userId uint64, amount uint64) error
+	GetEthUserRecordTotalByUser() (map[uint64]uint64, error)
+	ResetUserMyTotalAmount(ctx context.Context, totals map[uint64]uint64) error
 	UpdateWithdraw(ctx context.Context, id uint64, status string) (*Withdraw, error)
+	UpdateWithdrawSigned(ctx context.Context, id uint64, txHash string, nonce uint64, rawTx string) error
+	UpdateWithdrawSending(ctx context.Context, id uint64, txHash string, nonce uint64) error
+	UpdateWithdrawResult(ctx context.Context, id uint64, status string, blockNumber uint64) error
+	ResetWithdrawTx(ctx context.Context, id uint64, fromStatus string, remark string) error
+	GetWithdrawsByStatus(status string, limit int) ([]*Withdraw, error)
+	GetWithdrawsPassOrRewarded(limit int) ([]*Withdraw, error)
 	InsertCardRecord(ctx context.Context, userId, recordType uint64, remark string, code string, opt string) error
 	UpdateCardTwo(ctx context.Context, id uint64) error
 	GetUserCardTwo() ([]*Reward, error)
@@ -165,6 +402,13 @@ type UserRepo interface {
 	SetUserCount(ctx context.Context, userId uint64) (bool, error)
 	GetConfigs() ([]*Config, error)
 	UpdateConfig(ctx context.Context, id int64, value string) (bool, error)
+	GetCardOrders(orderType uint64, status ...string) ([]*CardOrder, error)
+	GetCardOrderById(id uint64) (*CardOrder, error)
+	GetCardOrderPage(b *Pagination, status string) ([]*CardOrder, error, int64)
+	AddCardOrderAttempt(ctx context.Context, userId, orderType uint64, orderNo string, deadline time.Time) (*CardOrder, error)
+	UpdateCardOrderStatus(ctx context.Context, id uint64, fromStatus, status, remark string) error
+	ResetCardOrder(ctx context.Context, id uint64, fromStatus string, deadline time.Time) error
+	CloseCardOrder(ctx context.Context, userId, orderType uint64, status string) error
 }
 
 type UserUseCase struct {
@@ -191,47 +435,184 @@ type Pagination struct {
 func (uuc *UserUseCase) GetEthUserRecordLast() (int64, error) {
 	return uuc.repo.GetEthUserRecordLast()
 }
+
+func (uuc *UserUseCase) GetDepositCheckpoint(name string) (*DepositCheckpoint, error) {
+	return uuc.repo.GetDepositCheckpoint(name)
+}
+
+func (uuc *UserUseCase) SetDepositCheckpoint(ctx context.Context, name string, blockNumber uint64, blockHash string) error {
+	return uuc.repo.SetDepositCheckpoint(ctx, name, blockNumber, blockHash)
+}
+
+// GetDepositConfig 充值扫描配置，mode: log 按事件扫块（默认），index 按合约下标轮询
+func (uuc *UserUseCase) GetDepositConfig() (string, uint64) {
+	var (
+		configs       []*Config
+		mode          = "log"
+		confirmations = uint64(15)
+	)
+
+	// 配置
+	configs, _ = uuc.repo.GetConfigByKeys("deposit_mode", "deposit_confirmations")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "deposit_mode" == vConfig.KeyName {
+				if "index" == vConfig.Value {
+					mode = vConfig.Value
+				}
+			}
+			if "deposit_confirmations" == vConfig.KeyName {
+				tmp, err := strconv.ParseUint(vConfig.Value, 10, 64)
+				if nil == err {
+					confirmations = tmp
+				}
+			}
+		}
+	}
+
+	return mode, confirmations
+}
+
+// CheckDepositMode 两种模式的去重键不同（log:链:交易:日志序号 / idx:链:合约:下标），
+// 同一笔充值在另一种模式下会再入账一次，已有另一种模式入账的不允许切换；老数据来自下标模式
+func (uuc *UserUseCase) CheckDepositMode(mode string) error {
+	prefixes := []string{"idx:", "legacy:"}
+	if "index" == mode {
+		prefixes = []string{"log:"}
+	}
+
+	exists, err := uuc.repo.HasDepositKeyPrefix(prefixes...)
+	if nil != err {
+		return err
+	}
+
+	if exists {
+		return errors.New(500, "DEPOSIT_MODE_CONFLICT", "已有另一种充值模式的入账记录，不能切换充值模式")
+	}
+
+	return nil
+}
+
+// GetDepositStartBlock 首次扫块的起始区块，按链配置，没有配置从最新确认区块开始
+func (uuc *UserUseCase) GetDepositStartBlock(chain string) uint64 {
+	var (
+		configs    []*Config
+		startBlock uint64
+	)
+
+	configs, _ = uuc.repo.GetConfigByKeys("deposit_start_block_" + chain)
+	if nil != configs {
+		for _, vConfig := range configs {
+			startBlock, _ = strconv.ParseUint(vConfig.Value, 10, 64)
+		}
+	}
+
+	return startBlock
+}
 func (uuc *UserUseCase) GetUserByAddress(Addresses ...string) (map[string]*User, error) {
 	return uuc.repo.GetUserByAddresses(Addresses...)
 }
 
-func (uuc *UserUseCase) DepositNew(ctx context.Context, userId uint64, amount uint64, eth *EthUserRecord, system bool) error {
-	// 推荐人
+// DepositNew 记录链上充值，用户存在且金额达标的直接入账，其余进入待处理
+func (uuc *UserUseCase) DepositNew(ctx context.Context, address string, eth *EthUserRecord) error {
 	var (
-		err error
+		user   *User
+		userId uint64
+		status = DepositStatusCredited
+		err    error
 	)
 
+	user, err = uuc.repo.GetUserByAddress(address)
+	if nil != err {
+		return err
+	}
+
+	if nil == user {
+		status = DepositStatusUnknownUser
+	} else {
+		userId = user.ID
+		if eth.RelAmount.LessThan(uuc.depositMinAmount()) {
+			status = DepositStatusBelowMinimum
+		}
+	}
+
 	// 入金
 	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-		// 充值记录
-		if !system {
-			_, err = uuc.repo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
-				Hash:      eth.Hash,
-				UserId:    eth.UserId,
-				Amount:    eth.Amount,
-				AmountTwo: amount,
-				Last:      eth.Last,
-			})
-			if nil != err {
-				return err
-			}
+		err = uuc.repo.CreateDepositPending(ctx, &DepositPending{
+			UserId:     userId,
+			Address:    address,
+			Chain:      eth.Chain,
+			Hash:       eth.Hash,
+			DepositKey: eth.DepositKey,
+			Amount:     eth.Amount,
+			AmountTwo:  eth.AmountTwo,
+			RelAmount:  eth.RelAmount,
+			Last:       eth.Last,
+			Status:     status,
+		})
+		if nil != err {
+			return err
 		}
 
-		return nil
+		if DepositStatusCredited != status {
+			return nil
+		}
+
+		return uuc.creditDeposit(ctx, userId, eth)
 	}); nil != err {
-		fmt.Println(err, "错误投资3", userId, amount)
+		if errors.IsConflict(err) { // 已记录，重复扫描
+			fmt.Println("充值已记录：", eth.DepositKey, address)
+			return nil
+		}
+
+		fmt.Println(err, "错误投资3", address, eth.RelAmount)
 		return err
 	}
 
-	// 推荐人
+	if DepositStatusCredited != status {
+		fmt.Println("充值待处理：", status, address, eth.DepositKey, eth.RelAmount)
+	}
+
+	return nil
+}
+
+// creditDeposit 充值入账，同时增加上级业绩，在事务内调用
+func (uuc *UserUseCase) creditDeposit(ctx context.Context, userId uint64, eth *EthUserRecord) error {
+	_, err := uuc.repo.CreateEthUserRecordListByHash(ctx, &EthUserRecord{
+		Hash:       eth.Hash,
+		UserId:     int64(userId),
+		Amount:     eth.Amount,
+		AmountTwo:  eth.AmountTwo,
+		RelAmount:  eth.RelAmount,
+		Last:       eth.Last,
+		Chain:      eth.Chain,
+		DepositKey: eth.DepositKey,
+	})
+	if nil != err {
+		return err
+	}
+
+	return uuc.addRecommendTotalAmount(ctx, userId, eth.AmountTwo)
+}
+
+// addRecommendTotalAmount 上级增加业绩，在事务内调用，任一失败整体回滚
+func (uuc *UserUseCase) addRecommendTotalAmount(ctx context.Context, userId uint64, amount uint64) error {
 	var (
 		userRecommend       *UserRecommend
 		tmpRecommendUserIds []string
+		err                 error
 	)
+	if 0 >= amount {
+		return nil
+	}
+
 	userRecommend, err = uuc.repo.GetUserRecommendByUserId(userId)
 	if nil != err {
 		return err
 	}
+	if nil == userRecommend {
+		return nil
+	}
 	if "" != userRecommend.RecommendCode {
 		tmpRecommendUserIds = strings.Split(userRecommend.RecommendCode, "D")
 	}
@@ -244,17 +625,189 @@ func (uuc *UserUseCase) DepositNew(ctx context.Context, userId uint64, amount ui
 		}
 
 		// 增加业绩
-		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-			err = uuc.repo.UpdateUserMyTotalAmountAdd(ctx, tmpUserId, amount)
-			if err != nil {
-				return err
+		err = uuc.repo.UpdateUserMyTotalAmountAdd(ctx, tmpUserId, amount)
+		if err != nil {
+			fmt.Println("遍历业绩：", err, tmpUserId, userId, amount)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RecomputeMyTotalAmount 按已入账充值重算所有用户业绩
+func (uuc *UserUseCase) RecomputeMyTotalAmount(ctx context.Context) (map[uint64]uint64, error) {
+	var (
+		userRecommends []*UserRecommend
+		depositTotal   map[uint64]uint64
+		err            error
+	)
+
+	depositTotal, err = uuc.repo.GetEthUserRecordTotalByUser()
+	if nil != err {
+		return nil, err
+	}
+
+	userRecommends, err = uuc.repo.GetUserRecommends()
+	if nil != err {
+		return nil, err
+	}
+
+	res := make(map[uint64]uint64, 0)
+	for _, v := range userRecommends {
+		amount, ok := depositTotal[v.UserId]
+		if !ok || 0 >= amount || "" == v.RecommendCode {
+			continue
+		}
+
+		for _, tmp := range strings.Split(v.RecommendCode, "D") {
+			tmpUserId, _ := strconv.ParseUint(tmp, 10, 64)
+			if 0 >= tmpUserId {
+				continue
 			}
 
-			return nil
-		}); nil != err {
-			fmt.Println("遍历业绩：", err, tmpUserId, eth)
+			res[tmpUserId] = addMyTotalAmount(res[tmpUserId], amount)
+		}
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.repo.ResetUserMyTotalAmount(ctx, res)
+	}); nil != err {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// addMyTotalAmount 业绩累加，落库为有符号bigint，超出按MaxInt64截断
+func addMyTotalAmount(total uint64, amount uint64) uint64 {
+	if total > math.MaxInt64 || amount > math.MaxInt64-total {
+		return math.MaxInt64
+	}
+
+	return total + amount
+}
+
+// depositMinAmount 最低充值金额
+func (uuc *UserUseCase) depositMinAmount() money.Money {
+	var (
+		configs   []*Config
+		minAmount = money.FromInt(10)
+	)
+
+	configs, _ = uuc.repo.GetConfigByKeys("deposit_min_amount")
+	if nil != configs {
+		for _, vConfig := range configs {
+			tmp, err := money.Parse(vConfig.Value)
+			if nil == err {
+				minAmount = tmp
+			}
+		}
+	}
+
+	return minAmount
+}
+
+// TokenToBalance 链上最小单位按币种精度换算为余额，返回余额和整数部分（业绩、amount_two按整数累计）
+// 整数部分落库为有符号bigint，超出按MaxInt64截断
+func TokenToBalance(value *big.Int, decimals uint8) (money.Money, uint64) {
+	if nil == value || 0 >= value.Sign() {
+		return money.Zero(), 0
+	}
+
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	whole := new(big.Int).Quo(value, unit)
+	balance := money.FromWei(value, decimals)
+
+	if !whole.IsInt64() {
+		return balance, math.MaxInt64
+	}
+
+	return balance, whole.Uint64()
+}
+
+// creditDepositPending 待处理充值入账
+func (uuc *UserUseCase) creditDepositPending(ctx context.Context, pending *DepositPending, userId uint64, remark string) error {
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err := uuc.repo.UpdateDepositPendingStatus(ctx, pending.ID, pending.Status, DepositStatusCredited, userId, remark)
+		if nil != err {
+			return err
+		}
+
+		return uuc.creditDeposit(ctx, userId, &EthUserRecord{
+			Hash:       pending.Hash,
+			Amount:     pending.Amount,
+			AmountTwo:  pending.AmountTwo,
+			RelAmount:  pending.RelAmount,
+			Last:       pending.Last,
+			Chain:      pending.Chain,
+			DepositKey: pending.DepositKey,
+		})
+	})
+}
+
+// ClaimPendingDeposits 注册后认领该地址之前的充值
+func (uuc *UserUseCase) ClaimPendingDeposits(ctx context.Context, userId uint64, address string) error {
+	pendings, err := uuc.repo.GetDepositPendingsByAddress(address, DepositStatusUnknownUser)
+	if nil != err {
+		return err
+	}
+
+	minAmount := uuc.depositMinAmount()
+	for _, v := range pendings {
+		if v.RelAmount.LessThan(minAmount) {
+			err = uuc.repo.UpdateDepositPendingStatus(ctx, v.ID, v.Status, DepositStatusBelowMinimum, userId, "")
+			if nil != err {
+				fmt.Println("认领充值失败：", v, err)
+			}
+			continue
+		}
+
+		err = uuc.creditDepositPending(ctx, v, userId, "注册认领")
+		if nil != err {
+			fmt.Println("认领充值失败：", v, err)
+		}
+	}
+
+	return nil
+}
+
+// ClaimUnknownDeposits 地址已注册的待认领充值
+func (uuc *UserUseCase) ClaimUnknownDeposits(ctx context.Context) error {
+	pendings, err := uuc.repo.GetRegisteredDepositPendings(DepositStatusUnknownUser, 100)
+	if nil != err {
+		return err
+	}
+
+	if 0 >= len(pendings) {
+		return nil
+	}
+
+	addresses := make([]string, 0, len(pendings))
+	for _, v := range pendings {
+		addresses = append(addresses, v.Address)
+	}
+
+	users, err := uuc.repo.GetUserByAddresses(addresses...)
+	if nil != err {
+		return err
+	}
+
+	claimed := make(map[string]bool, 0)
+	for _, v := range pendings {
+		if claimed[v.Address] {
+			continue
+		}
+
+		if _, ok := users[v.Address]; !ok {
 			continue
 		}
+
+		claimed[v.Address] = true
+		err = uuc.ClaimPendingDeposits(ctx, users[v.Address].ID, v.Address)
+		if nil != err {
+			fmt.Println("认领充值失败：", v.Address, err)
+		}
 	}
 
 	return nil
@@ -280,6 +833,9 @@ func (uuc *UserUseCase) OpenCardHandle(ctx context.Context) error {
 		return nil
 	}
 
+	maxAttempts, deadline := uuc.cardOrderLimit()
+	blocked := uuc.cardOrderBlocked(CardOrderTypeHolder)
+
 	//var (
 	//	products          *CardProductListResponse
 	//	productIdUse      string
@@ -377,7 +933,7 @@ func (uuc *UserUseCase) OpenCardHandle(ctx context.Context) error {
 
 		if !openRes {
 			fmt.Println("回滚了用户", user)
-			backAmount := float64(15)
+			backAmount := money.FromInt(15)
 
 			err = uuc.backCard(ctx, user.ID, backAmount)
 			if nil != err {
@@ -387,6 +943,11 @@ func (uuc *UserUseCase) OpenCardHandle(ctx context.Context) error {
 			continue
 		}
 
+		// 死信或已人工处理
+		if v, ok := blocked[user.ID]; ok && v.OrderNo == user.CardUserId {
+			continue
+		}
+
 		//
 		var (
 			resHolder *QueryCardHolderResponse
@@ -399,24 +960,26 @@ func (uuc *UserUseCase) OpenCardHandle(ctx context.Context) error {
 		}
 
 		if "ACTIVE" == resHolder.Data.Status {
-
+			uuc.cardOrderClose(ctx, user.ID, CardOrderTypeHolder, "done")
 		} else if "PENDING" == resHolder.Data.Status {
+			uuc.cardOrderPending(ctx, user.ID, CardOrderTypeHolder, user.CardUserId, maxAttempts, deadline)
 			continue
 		} else {
 			fmt.Println(user, err, "持卡人创建失败", resHolder)
-			backAmount := float64(15)
+			backAmount := money.FromInt(15)
 
 			err = uuc.backCard(ctx, user.ID, backAmount)
 			if nil != err {
 				fmt.Println("回滚了用户失败", user, err)
 			}
+			uuc.cardOrderClose(ctx, user.ID, CardOrderTypeHolder, "refunded")
 			continue
 		}
 
 		resCreatCard, err = CreateCardRequestWithSign(0, holderId, productIdUseInt64)
 		if nil == resCreatCard || 200 != resCreatCard.Code || err != nil {
 			fmt.Println("开卡订单创建失败", user, resCreatCard, err)
-			backAmount := float64(15)
+			backAmount := money.FromInt(15)
 
 			err = uuc.backCard(ctx, user.ID, backAmount)
 			if nil != err {
@@ -428,7 +991,7 @@ func (uuc *UserUseCase) OpenCardHandle(ctx context.Context) error {
 
 		if 0 >= len(resCreatCard.Data.CardID) || 0 >= len(resCreatCard.Data.OrderNo) {
 			fmt.Println("开卡订单信息错误", resCreatCard, err)
-			backAmount := float64(15)
+			backAmount := money.FromInt(15)
 
 			err = uuc.backCard(ctx, user.ID, backAmount)
 			if nil != err {
@@ -473,6 +1036,9 @@ func (uuc *UserUseCase) OpenCardTwoHandle(ctx context.Context) error {
 		return nil
 	}
 
+	maxAttempts, deadline := uuc.cardOrderLimit()
+	blocked := uuc.cardOrderBlocked(CardOrderTypeHolderTwo)
+
 	//var (
 	//	products          *CardProductListResponse
 	//	productIdUse      string
@@ -510,11 +1076,11 @@ func (uuc *UserUseCase) OpenCardTwoHandle(ctx context.Context) error {
 	//}
 
 	for _, user := range userOpenCard {
-		backAmount := float64(100)
+		backAmount := money.FromInt(100)
 		if 2 == user.CardType {
-			backAmount = float64(500)
+			backAmount = money.FromInt(500)
 		} else if 3 == user.CardType {
-			backAmount = float64(1000)
+			backAmount = money.FromInt(1000)
 		}
 
 		//var (
@@ -585,6 +1151,11 @@ func (uuc *UserUseCase) OpenCardTwoHandle(ctx context.Context) error {
 			continue
 		}
 
+		// 死信或已人工处理
+		if v, ok := blocked[user.ID]; ok && v.OrderNo == user.CardUserId {
+			continue
+		}
+
 		//
 		var (
 			resHolder *QueryCardHolderResponse
@@ -597,8 +1168,9 @@ func (uuc *UserUseCase) OpenCardTwoHandle(ctx context.Context) error {
 		}
 
 		if "ACTIVE" == resHolder.Data.Status {
-
+			uuc.cardOrderClose(ctx, user.ID, CardOrderTypeHolderTwo, "done")
 		} else if "PENDING" == resHolder.Data.Status {
+			uuc.cardOrderPending(ctx, user.ID, CardOrderTypeHolderTwo, user.CardUserId, maxAttempts, deadline)
 			continue
 		} else {
 			fmt.Println(user, err, "持卡人创建失败", resHolder)
@@ -606,6 +1178,7 @@ func (uuc *UserUseCase) OpenCardTwoHandle(ctx context.Context) error {
 			if nil != err {
 				fmt.Println("回滚了用户失败", user, err)
 			}
+			uuc.cardOrderClose(ctx, user.ID, CardOrderTypeHolderTwo, "refunded")
 			continue
 		}
 
@@ -680,6 +1253,9 @@ func (uuc *UserUseCase) CardStatusHandle(ctx context.Context) error {
 		return nil
 	}
 
+	maxAttempts, deadline := uuc.cardOrderLimit()
+	blocked := uuc.cardOrderBlocked(CardOrderTypeCard)
+
 	for _, user := range userOpenCard {
 		// 查询状态。成功分红
 		var (
@@ -689,6 +1265,11 @@ func (uuc *UserUseCase) CardStatusHandle(ctx context.Context) error {
 			continue
 		}
 
+		// 死信或已人工处理
+		if v, ok := blocked[user.ID]; ok && v.OrderNo == user.Card {
+			continue
+		}
+
 		resCard, err = GetCardInfoRequestWithSign(user.Card)
 		if nil == resCard || 200 != resCard.Code || err != nil {
 			fmt.Println(resCard, err)
@@ -708,17 +1289,20 @@ func (uuc *UserUseCase) CardStatusHandle(ctx context.Context) error {
 				fmt.Println("err，开卡成功", err, user.ID)
 				continue
 			}
+			uuc.cardOrderClose(ctx, user.ID, CardOrderTypeCard, "done")
 		} else if "PENDING" == resCard.Data.CardStatus || "PROCESSING" == resCard.Data.CardStatus {
 			fmt.Println("开卡状态，待处理：", resCard, user.ID)
+			uuc.cardOrderPending(ctx, user.ID, CardOrderTypeCard, user.Card, maxAttempts, deadline)
 			continue
 		} else {
 			fmt.Println("开卡状态，失败：", resCard, user.ID)
-			backAmount := float64(15)
+			backAmount := money.FromInt(15)
 
 			err = uuc.backCard(ctx, user.ID, backAmount)
 			if nil != err {
 				fmt.Println("回滚了用户失败", user, err)
 			}
+			uuc.cardOrderClose(ctx, user.ID, CardOrderTypeCard, "refunded")
 			continue
 		}
 
@@ -765,7 +1349,7 @@ func (uuc *UserUseCase) CardStatusHandle(ctx context.Context) error {
 			lastVip = usersMap[tmpUserId].Vip
 
 			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				err = uuc.repo.CreateCardRecommend(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
+				err = uuc.repo.CreateCardRecommend(ctx, tmpUserId, money.FromUint(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
 				if err != nil {
 					return err
 				}
@@ -793,11 +1377,11 @@ func (uuc *UserUseCase) CardStatusHandleTwo(ctx context.Context) error {
 
 	var (
 		configs       []*Config
-		vipThreeThree float64
-		vipThreeTwo   float64
-		vipThreeOne   float64
-		vipThreeFour  float64
-		vipThreeFive  float64
+		vipThreeThree money.Money
+		vipThreeTwo   money.Money
+		vipThreeOne   money.Money
+		vipThreeFour  money.Money
+		vipThreeFive  money.Money
 	)
 
 	// 配置
@@ -805,19 +1389,19 @@ func (uuc *UserUseCase) CardStatusHandleTwo(ctx context.Context) error {
 	if nil != configs {
 		for _, vConfig := range configs {
 			if "card_two_three" == vConfig.KeyName {
-				vipThreeThree, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeThree, _ = money.Parse(vConfig.Value)
 			}
 			if "card_two_two" == vConfig.KeyName {
-				vipThreeTwo, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeTwo, _ = money.Parse(vConfig.Value)
 			}
 			if "card_two_one" == vConfig.KeyName {
-				vipThreeOne, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeOne, _ = money.Parse(vConfig.Value)
 			}
 			if "card_two_four" == vConfig.KeyName {
-				vipThreeFour, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeFour, _ = money.Parse(vConfig.Value)
 			}
 			if "card_two_five" == vConfig.KeyName {
-				vipThreeFive, _ = strconv.ParseFloat(vConfig.Value, 10)
+				vipThreeFive, _ = money.Parse(vConfig.Value)
 			}
 		}
 	}
@@ -846,12 +1430,15 @@ func (uuc *UserUseCase) CardStatusHandleTwo(ctx context.Context) error {
 		return nil
 	}
 
+	maxAttempts, deadline := uuc.cardOrderLimit()
+	blocked := uuc.cardOrderBlocked(CardOrderTypeCardTwo)
+
 	for _, user := range userOpenCard {
-		backAmount := float64(100)
+		backAmount := money.FromInt(100)
 		if 2 == user.CardType {
-			backAmount = float64(500)
+			backAmount = money.FromInt(500)
 		} else if 3 == user.CardType {
-			backAmount = float64(1000)
+			backAmount = money.FromInt(1000)
 		} else {
 			continue
 		}
@@ -864,6 +1451,11 @@ func (uuc *UserUseCase) CardStatusHandleTwo(ctx context.Context) error {
 			continue
 		}
 
+		// 死信或已人工处理
+		if v, ok := blocked[user.ID]; ok && v.OrderNo == user.CardIdTwo {
+			continue
+		}
+
 		resCard, err = GetCardInfoRequestWithSign(user.CardIdTwo)
 		if nil == resCard || 200 != resCard.Code || err != nil {
 			fmt.Println(resCard, err)
@@ -883,8 +1475,10 @@ func (uuc *UserUseCase) CardStatusHandleTwo(ctx context.Context) error {
 				fmt.Println("err，开卡成功", err, user.ID)
 				continue
 			}
+			uuc.cardOrderClose(ctx, user.ID, CardOrderTypeCardTwo, "done")
 		} else if "PENDING" == resCard.Data.CardStatus || "PROCESSING" == resCard.Data.CardStatus {
 			//fmt.Println("开卡状态，待处理：", resCard, user.ID)
+			uuc.cardOrderPending(ctx, user.ID, CardOrderTypeCardTwo, user.CardIdTwo, maxAttempts, deadline)
 			continue
 		} else {
 			fmt.Println("开卡状态，失败：", resCard, user.ID)
@@ -892,6 +1486,7 @@ func (uuc *UserUseCase) CardStatusHandleTwo(ctx context.Context) error {
 			if nil != err {
 				fmt.Println("回滚了用户失败", user, err)
 			}
+			uuc.cardOrderClose(ctx, user.ID, CardOrderTypeCardTwo, "refunded")
 			continue
 		}
 
@@ -922,15 +1517,15 @@ func (uuc *UserUseCase) CardStatusHandleTwo(ctx context.Context) error {
 			if 0 >= tmpUserId {
 				continue
 			}
-			tmpAmount := backAmount * vipThreeOne
+			tmpAmount := backAmount.Mul(vipThreeOne)
 			if 3 == tmp {
-				tmpAmount = backAmount * vipThreeTwo
+				tmpAmount = backAmount.Mul(vipThreeTwo)
 			} else if 4 == tmp {
-				tmpAmount = backAmount * vipThreeThree
+				tmpAmount = backAmount.Mul(vipThreeThree)
 			} else if 5 == tmp {
-				tmpAmount = backAmount * vipThreeFour
+				tmpAmount = backAmount.Mul(vipThreeFour)
 			} else if 6 == tmp {
-				tmpAmount = backAmount * vipThreeFive
+				tmpAmount = backAmount.Mul(vipThreeFive)
 			}
 
 			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
@@ -1106,7 +1701,7 @@ func (uuc *UserUseCase) CardTwoStatusHandle(ctx context.Context) error {
 			}
 
 			if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
-				err = uuc.repo.CreateCardRecommendTwo(ctx, tmpUserId, float64(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
+				err = uuc.repo.CreateCardRecommendTwo(ctx, tmpUserId, money.FromUint(tmpAmount), usersMap[tmpUserId].Vip, user.Address)
 				if err != nil {
 					return err
 				}
@@ -1121,7 +1716,7 @@ func (uuc *UserUseCase) CardTwoStatusHandle(ctx context.Context) error {
 	return nil
 }
 
-func (uuc *UserUseCase) backCard(ctx context.Context, userId uint64, amount float64) error {
+func (uuc *UserUseCase) backCard(ctx context.Context, userId uint64, amount money.Money) error {
 	var (
 		err error
 	)
@@ -1140,7 +1735,7 @@ func (uuc *UserUseCase) backCard(ctx context.Context, userId uint64, amount floa
 	return nil
 }
 
-func (uuc *UserUseCase) backCardTwo(ctx context.Context, userId uint64, amount float64) error {
+func (uuc *UserUseCase) backCardTwo(ctx context.Context, userId uint64, amount money.Money) error {
 	var (
 		err error
 	)
@@ -1159,20 +1754,1583 @@ func (uuc *UserUseCase) backCardTwo(ctx context.Context, userId uint64, amount f
 	return nil
 }
 
-func (uuc *UserUseCase) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error) {
-	return uuc.repo.GetWithdrawPassOrRewardedFirst(ctx)
-}
+// cardOrderLimit 开卡订单最大查询次数和最长等待时间
+func (uuc *UserUseCase) cardOrderLimit() (uint64, time.Duration) {
+	var (
+		configs     []*Config
+		maxAttempts = uint64(2000)
+		deadline    = 72 * time.Hour
+	)
+
+	// 配置
+	configs, _ = uuc.repo.GetConfigByKeys("card_order_max_attempts", "card_order_deadline_hours")
+	if nil != configs {
+		for _, vConfig := range configs {
+			tmp, _ := strconv.ParseUint(vConfig.Value, 10, 64)
+			if 0 >= tmp {
+				continue
+			}
+
+			if "card_order_max_attempts" == vConfig.KeyName {
+				maxAttempts = tmp
+			}
+			if "card_order_deadline_hours" == vConfig.KeyName {
+				deadline = time.Duration(tmp) * time.Hour
+			}
+		}
+	}
+
+	return maxAttempts, deadline
+}
+
+// cardOrderBlocked 已进入死信或已人工处理的订单，不再轮询
+func (uuc *UserUseCase) cardOrderBlocked(orderType uint64) map[uint64]*CardOrder {
+	res := make(map[uint64]*CardOrder, 0)
+
+	cardOrders, err := uuc.repo.GetCardOrders(orderType, "dead", "resolved")
+	if nil != err {
+		fmt.Println("死信订单查询失败", orderType, err)
+		return res
+	}
+
+	for _, v := range cardOrders {
+		res[v.UserId] = v
+	}
+
+	return res
+}
+
+// cardOrderPending 订单仍在处理中，累计查询次数，超过次数或截止时间进入死信
+func (uuc *UserUseCase) cardOrderPending(ctx context.Context, userId, orderType uint64, orderNo string, maxAttempts uint64, deadline time.Duration) {
+	cardOrder, err := uuc.repo.AddCardOrderAttempt(ctx, userId, orderType, orderNo, time.Now().UTC().Add(deadline))
+	if nil != err || nil == cardOrder {
+		fmt.Println("开卡订单计数失败", userId, orderType, err)
+		return
+	}
+
+	remark := ""
+	if maxAttempts <= cardOrder.Attempts {
+		remark = "超过最大查询次数"
+	} else if cardOrder.Deadline.Before(time.Now().UTC()) {
+		remark = "超过截止时间"
+	} else {
+		return
+	}
+
+	fmt.Println("开卡订单进入死信：", cardOrder, remark)
+	err = uuc.repo.UpdateCardOrderStatus(ctx, cardOrder.ID, cardOrder.Status, "dead", remark)
+	if nil != err {
+		fmt.Println("开卡订单进入死信失败", cardOrder, err)
+	}
+}
+
+// cardOrderClose 订单有结果后关闭计数
+func (uuc *UserUseCase) cardOrderClose(ctx context.Context, userId, orderType uint64, status string) {
+	err := uuc.repo.CloseCardOrder(ctx, userId, orderType, status)
+	if nil != err {
+		fmt.Println("开卡订单关闭失败", userId, orderType, err)
+	}
+}
+
+// cardOrderStillPending 用户仍停留在该订单的待处理状态，才允许退款
+func cardOrderStillPending(user *User, cardOrder *CardOrder) bool {
+	switch cardOrder.OrderType {
+	case CardOrderTypeHolder:
+		return "do" == user.CardOrderId && cardOrder.OrderNo == user.CardUserId
+	case CardOrderTypeCard:
+		return cardOrder.OrderNo == user.Card && "no" == user.CardNumber
+	case CardOrderTypeHolderTwo:
+		return 1 == user.CardTwo && cardOrder.OrderNo == user.CardUserId
+	case CardOrderTypeCardTwo:
+		return 3 == user.CardTwo && cardOrder.OrderNo == user.CardIdTwo
+	}
+
+	return false
+}
+
+func (uuc *UserUseCase) AdminCardOrderList(ctx context.Context, req *pb.AdminCardOrderListRequest) (*pb.AdminCardOrderListReply, error) {
+	var (
+		cardOrders []*CardOrder
+		users      map[uint64]*User
+		userIds    []uint64
+		count      int64
+		err        error
+	)
+
+	res := &pb.AdminCardOrderListReply{
+		List: make([]*pb.AdminCardOrderListReply_List, 0),
+	}
+
+	status := "dead"
+	if "" != req.Status {
+		status = req.Status
+	}
+
+	cardOrders, err, count = uuc.repo.GetCardOrderPage(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, status)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = uint64(count)
+
+	for _, v := range cardOrders {
+		userIds = append(userIds, v.UserId)
+	}
+
+	users, _ = uuc.repo.GetUserByUserIds(userIds...)
+	for _, v := range cardOrders {
+		tmpAddress := ""
+		if nil != users {
+			if _, ok := users[v.UserId]; ok {
+				tmpAddress = users[v.UserId].Address
+			}
+		}
+
+		res.List = append(res.List, &pb.AdminCardOrderListReply_List{
+			Id:        v.ID,
+			UserId:    v.UserId,
+			Address:   tmpAddress,
+			OrderType: v.OrderType,
+			OrderNo:   v.OrderNo,
+			Attempts:  v.Attempts,
+			Status:    v.Status,
+			Remark:    v.Remark,
+			Deadline:  v.Deadline.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminCardOrderHandle(ctx context.Context, req *pb.AdminCardOrderHandleRequest) (*pb.AdminCardOrderHandleReply, error) {
+	var (
+		cardOrder *CardOrder
+		user      *User
+		err       error
+	)
+
+	res := &pb.AdminCardOrderHandleReply{}
+
+	cardOrder, err = uuc.repo.GetCardOrderById(req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+
+	if nil == cardOrder {
+		return res, errors.New(500, "CARD_ORDER_ERROR", "订单不存在")
+	}
+
+	switch req.SendBody.Action {
+	case "retry":
+		// 已人工处理、已退款的不能再重试，用户开卡状态变了也不能
+		if "dead" != cardOrder.Status {
+			return res, errors.New(500, "CARD_ORDER_ERROR", "订单状态不允许重试")
+		}
+
+		user, err = uuc.repo.GetUserById(cardOrder.UserId)
+		if nil != err {
+			return res, err
+		}
+
+		if nil == user || !cardOrderStillPending(user, cardOrder) {
+			return res, errors.New(500, "CARD_ORDER_ERROR", "用户开卡状态已变化，不能重试")
+		}
+
+		_, deadline := uuc.cardOrderLimit()
+		err = uuc.repo.ResetCardOrder(ctx, cardOrder.ID, cardOrder.Status, time.Now().UTC().Add(deadline))
+		if nil != err {
+			return res, err
+		}
+
+	case "resolve":
+		if "dead" != cardOrder.Status && "doing" != cardOrder.Status {
+			return res, errors.New(500, "CARD_ORDER_ERROR", "订单状态不允许标记")
+		}
+
+		err = uuc.repo.UpdateCardOrderStatus(ctx, cardOrder.ID, cardOrder.Status, "resolved", "人工处理")
+		if nil != err {
+			return res, err
+		}
+
+	case "refund":
+		if "dead" != cardOrder.Status && "doing" != cardOrder.Status && "resolved" != cardOrder.Status {
+			return res, errors.New(500, "CARD_ORDER_ERROR", "订单状态不允许退款")
+		}
+
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			// 先按原状态改订单，并发重复点击只有一个成功；锁用户行后再核对开卡状态
+			err = uuc.repo.UpdateCardOrderStatus(ctx, cardOrder.ID, cardOrder.Status, "refunded", "人工退款")
+			if nil != err {
+				return err
+			}
+
+			user, err = uuc.repo.LockUser(ctx, cardOrder.UserId)
+			if nil != err {
+				return err
+			}
+
+			if !cardOrderStillPending(user, cardOrder) {
+				return errors.New(500, "CARD_ORDER_ERROR", "用户开卡状态已变化，不能退款")
+			}
+
+			if CardOrderTypeHolder == cardOrder.OrderType || CardOrderTypeCard == cardOrder.OrderType {
+				err = uuc.repo.UpdateCardNo(ctx, user.ID, money.FromInt(15))
+			} else {
+				backAmount := money.FromInt(100)
+				if 2 == user.CardType {
+					backAmount = money.FromInt(500)
+				} else if 3 == user.CardType {
+					backAmount = money.FromInt(1000)
+				}
+
+				err = uuc.repo.UpdateCardNoTwo(ctx, user.ID, backAmount)
+			}
+
+			return err
+		}); nil != err {
+			return res, err
+		}
+
+	default:
+		return res, errors.New(500, "CARD_ORDER_ERROR", "操作类型错误")
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminRecomputeTotalAmount(ctx context.Context, req *pb.AdminRecomputeTotalAmountRequest) (*pb.AdminRecomputeTotalAmountReply, error) {
+	totals, err := uuc.RecomputeMyTotalAmount(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return &pb.AdminRecomputeTotalAmountReply{Count: uint64(len(totals))}, nil
+}
+
+func (uuc *UserUseCase) AdminDepositPendingList(ctx context.Context, req *pb.AdminDepositPendingListRequest) (*pb.AdminDepositPendingListReply, error) {
+	var (
+		pendings []*DepositPending
+		count    int64
+		err      error
+	)
+
+	res := &pb.AdminDepositPendingListReply{
+		List: make([]*pb.AdminDepositPendingListReply_List, 0),
+	}
+
+	status := DepositStatusUnknownUser
+	if "" != req.Status {
+		status = req.Status
+	}
+
+	pendings, err, count = uuc.repo.GetDepositPendingPage(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, status, req.Address)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = uint64(count)
+
+	for _, v := range pendings {
+		res.List = append(res.List, &pb.AdminDepositPendingListReply_List{
+			Id:        v.ID,
+			UserId:    v.UserId,
+			Address:   v.Address,
+			Chain:     v.Chain,
+			Hash:      v.Hash,
+			Amount:    v.AmountTwo,
+			Status:    v.Status,
+			Remark:    v.Remark,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminDepositPendingHandle(ctx context.Context, req *pb.AdminDepositPendingHandleRequest) (*pb.AdminDepositPendingHandleReply, error) {
+	var (
+		pending *DepositPending
+		user    *User
+		err     error
+	)
+
+	res := &pb.AdminDepositPendingHandleReply{}
+
+	pending, err = uuc.repo.GetDepositPendingById(req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+
+	if nil == pending {
+		return res, errors.New(500, "DEPOSIT_PENDING_ERROR", "充值记录不存在")
+	}
+
+	if DepositStatusUnknownUser != pending.Status && DepositStatusBelowMinimum != pending.Status {
+		return res, errors.New(500, "DEPOSIT_PENDING_ERROR", "充值状态不允许操作")
+	}
+
+	switch req.SendBody.Action {
+	case "credit":
+		user, err = uuc.repo.GetUserByAddress(pending.Address)
+		if nil != err {
+			return res, err
+		}
+
+		if nil == user {
+			return res, errors.New(500, "DEPOSIT_PENDING_ERROR", "充值地址未注册")
+		}
+
+		remark := "人工入账"
+		if "" != req.SendBody.Remark {
+			remark = req.SendBody.Remark
+		}
+
+		err = uuc.creditDepositPending(ctx, pending, user.ID, remark)
+		if nil != err {
+			return res, err
+		}
+	case "reject":
+		remark := "人工拒绝"
+		if "" != req.SendBody.Remark {
+			remark = req.SendBody.Remark
+		}
+
+		err = uuc.repo.UpdateDepositPendingStatus(ctx, pending.ID, pending.Status, DepositStatusRejected, pending.UserId, remark)
+		if nil != err {
+			return res, err
+		}
+	default:
+		return res, errors.New(500, "DEPOSIT_PENDING_ERROR", "操作类型错误")
+	}
+
+	return res, nil
+}
+
+// WithdrawRisk 提现风控配置，0为不限制
+type WithdrawRisk struct {
+	AutoApproveAmount money.Money // 低于该金额自动审核通过，0全部人工审核
+	MinAmount         money.Money // 单笔最低
+	DailyLimit        money.Money // 单用户24小时累计
+	WeeklyLimit       money.Money // 单用户7天累计
+	DailyCount        int64       // 单用户24小时笔数
+	AddressCooldown   time.Duration
+	HourlyCap         money.Money // 热钱包每小时出金
+}
+
+// GetWithdrawRisk 提现风控配置
+func (uuc *UserUseCase) GetWithdrawRisk() *WithdrawRisk {
+	var (
+		configs []*Config
+		res     = &WithdrawRisk{}
+	)
+
+	// 配置
+	configs, _ = uuc.repo.GetConfigByKeys(
+		"withdraw_auto_approve_amount",
+		"withdraw_min_amount",
+		"withdraw_daily_limit",
+		"withdraw_weekly_limit",
+		"withdraw_daily_count",
+		"withdraw_address_cooldown_hours",
+		"withdraw_hourly_cap",
+	)
+	if nil != configs {
+		for _, vConfig := range configs {
+			tmp, err := money.Parse(vConfig.Value)
+			if nil != err || 0 > tmp.Sign() {
+				continue
+			}
+
+			switch vConfig.KeyName {
+			case "withdraw_auto_approve_amount":
+				res.AutoApproveAmount = tmp
+			case "withdraw_min_amount":
+				res.MinAmount = tmp
+			case "withdraw_daily_limit":
+				res.DailyLimit = tmp
+			case "withdraw_weekly_limit":
+				res.WeeklyLimit = tmp
+			case "withdraw_daily_count":
+				res.DailyCount = int64(tmp.Uint64())
+			case "withdraw_address_cooldown_hours":
+				res.AddressCooldown = time.Duration(tmp.Float64() * float64(time.Hour))
+			case "withdraw_hourly_cap":
+				res.HourlyCap = tmp
+			}
+		}
+	}
+
+	return res
+}
+
+// withdrawReview 命中风控返回转人工原因，不拦截申请
+func (uuc *UserUseCase) withdrawReview(ctx context.Context, risk *WithdrawRisk, userId uint64, amount, amountRel money.Money, address string) (string, error) {
+	now := time.Now().UTC()
+
+	if 0 < risk.DailyLimit.Sign() || 0 < risk.DailyCount {
+		dayAmount, dayCount, err := uuc.repo.GetUserWithdrawTotal(ctx, userId, now.Add(-24*time.Hour))
+		if nil != err {
+			return "", err
+		}
+
+		if 0 < risk.DailyLimit.Sign() && dayAmount.Add(amount).GreaterThan(risk.DailyLimit) {
+			return "超过24小时提现额度", nil
+		}
+
+		if 0 < risk.DailyCount && dayCount+1 > risk.DailyCount {
+			return "超过24小时提现笔数", nil
+		}
+	}
+
+	if 0 < risk.WeeklyLimit.Sign() {
+		weekAmount, _, err := uuc.repo.GetUserWithdrawTotal(ctx, userId, now.Add(-7*24*time.Hour))
+		if nil != err {
+			return "", err
+		}
+
+		if weekAmount.Add(amount).GreaterThan(risk.WeeklyLimit) {
+			return "超过7天提现额度", nil
+		}
+	}
+
+	// 换了提现地址，冷却期内转人工
+	if 0 < risk.AddressCooldown {
+		firstUsed, changed, err := uuc.repo.GetUserWithdrawAddressFirstUse(ctx, userId, address)
+		if nil != err {
+			return "", err
+		}
+
+		if changed && (nil == firstUsed || firstUsed.After(now.Add(-risk.AddressCooldown))) {
+			return "提现地址变更冷却期", nil
+		}
+	}
+
+	if 0 < risk.HourlyCap.Sign() {
+		hourAmount, err := uuc.repo.GetWithdrawOutflow(ctx, now.Add(-time.Hour))
+		if nil != err {
+			return "", err
+		}
+
+		if hourAmount.Add(amountRel).GreaterThan(risk.HourlyCap) {
+			return "超过热钱包每小时出金上限", nil
+		}
+	}
+
+	return "", nil
+}
+
+// WithdrawOverHourlyCap 发放前检查热钱包每小时出金，超过则等下一轮
+func (uuc *UserUseCase) WithdrawOverHourlyCap(ctx context.Context, amountRel money.Money) (bool, error) {
+	risk := uuc.GetWithdrawRisk()
+	if 0 >= risk.HourlyCap.Sign() {
+		return false, nil
+	}
+
+	hourAmount, err := uuc.repo.GetWithdrawOutflow(ctx, time.Now().UTC().Add(-time.Hour))
+	if nil != err {
+		return true, err
+	}
+
+	return hourAmount.Add(amountRel).GreaterThan(risk.HourlyCap), nil
+}
+
+// WithdrawAboveHourlyCap 单笔超过每小时出金上限，等多久都发不出去
+func (uuc *UserUseCase) WithdrawAboveHourlyCap(amountRel money.Money) bool {
+	risk := uuc.GetWithdrawRisk()
+	return 0 < risk.HourlyCap.Sign() && amountRel.GreaterThan(risk.HourlyCap)
+}
+
+// WithdrawToReview 待发放的转回人工审核
+func (uuc *UserUseCase) WithdrawToReview(ctx context.Context, withdraw *Withdraw, remark string) error {
+	return uuc.repo.UpdateWithdrawStatus(ctx, withdraw.ID, withdraw.Status, WithdrawStatusRequested, remark)
+}
+
+// WithdrawFee 提现手续费
+// withdraw_fee_mode: flat 固定，percent 按比例，tiered 按vip等级比例，不配置不收
+// withdraw_fee_vip_tiers: vip:比例，逗号分隔，如 0:0.05,5:0.03,10:0.01，取不超过用户vip的最高档
+// withdraw_fee_min: 按比例收取时的最低手续费
+func (uuc *UserUseCase) WithdrawFee(user *User, amount money.Money) money.Money {
+	var (
+		configs []*Config
+		mode    string
+		flat    money.Money
+		percent money.Money
+		minFee  money.Money
+		tiers   string
+	)
+
+	// 配置
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_fee_mode", "withdraw_fee_flat", "withdraw_fee_percent", "withdraw_fee_min", "withdraw_fee_vip_tiers")
+	if nil != configs {
+		for _, vConfig := range configs {
+			switch vConfig.KeyName {
+			case "withdraw_fee_mode":
+				mode = vConfig.Value
+			case "withdraw_fee_flat":
+				flat, _ = money.Parse(vConfig.Value)
+			case "withdraw_fee_percent":
+				percent, _ = money.Parse(vConfig.Value)
+			case "withdraw_fee_min":
+				minFee, _ = money.Parse(vConfig.Value)
+			case "withdraw_fee_vip_tiers":
+				tiers = vConfig.Value
+			}
+		}
+	}
+
+	fee := money.Zero()
+	switch mode {
+	case "flat":
+		fee = flat
+	case "percent":
+		fee = amount.Mul(percent)
+	case "tiered":
+		tierVip := int64(-1)
+		for _, tier := range strings.Split(tiers, ",") {
+			tmp := strings.Split(strings.TrimSpace(tier), ":")
+			if 2 != len(tmp) {
+				continue
+			}
+
+			vip, err := strconv.ParseUint(strings.TrimSpace(tmp[0]), 10, 64)
+			if nil != err || vip > user.Vip || int64(vip) <= tierVip {
+				continue
+			}
+
+			rate, err := money.Parse(tmp[1])
+			if nil != err {
+				continue
+			}
+
+			tierVip = int64(vip)
+			percent = rate
+		}
+
+		fee = amount.Mul(percent)
+	}
+
+	if "percent" == mode || "tiered" == mode {
+		if fee.LessThan(minFee) {
+			fee = minFee
+		}
+	}
+
+	if 0 > fee.Sign() {
+		fee = money.Zero()
+	}
+
+	return fee
+}
+
+type TransferConfig struct {
+	MinAmount  money.Money // 单笔最低
+	FeePercent money.Money // 手续费比例
+	FeeMin     money.Money // 最低手续费
+	DailyLimit money.Money // 单用户24小时累计，0不限
+	DailyCount int64       // 单用户24小时笔数，0不限
+}
+
+// GetTransferConfig 划转配置
+func (uuc *UserUseCase) GetTransferConfig() *TransferConfig {
+	var (
+		configs []*Config
+	)
+
+	res := &TransferConfig{}
+
+	// 配置
+	configs, _ = uuc.repo.GetConfigByKeys("transfer_min_amount", "transfer_fee_percent", "transfer_fee_min", "transfer_daily_limit", "transfer_daily_count")
+	if nil != configs {
+		for _, vConfig := range configs {
+			switch vConfig.KeyName {
+			case "transfer_min_amount":
+				res.MinAmount, _ = money.Parse(vConfig.Value)
+			case "transfer_fee_percent":
+				res.FeePercent, _ = money.Parse(vConfig.Value)
+			case "transfer_fee_min":
+				res.FeeMin, _ = money.Parse(vConfig.Value)
+			case "transfer_daily_limit":
+				res.DailyLimit, _ = money.Parse(vConfig.Value)
+			case "transfer_daily_count":
+				res.DailyCount, _ = strconv.ParseInt(vConfig.Value, 10, 64)
+			}
+		}
+	}
+
+	return res
+}
+
+// TransferFee 按比例收取，不低于最低手续费
+func (c *TransferConfig) TransferFee(amount money.Money) money.Money {
+	fee := amount.Mul(c.FeePercent)
+	if fee.LessThan(c.FeeMin) {
+		fee = c.FeeMin
+	}
+
+	if 0 > fee.Sign() {
+		fee = money.Zero()
+	}
+
+	return fee
+}
+
+// Transfer 用户间划转，转出方扣 amount，收款方到账扣除手续费后的金额，双方各记一条流水
+func (uuc *UserUseCase) Transfer(ctx context.Context, userId uint64, toAddress string, amount money.Money) (*pb.TransferReply, error) {
+	if 0 >= amount.Sign() {
+		return nil, errors.New(500, "TRANSFER_ERROR", "划转金额错误")
+	}
+
+	if "" == toAddress {
+		return nil, errors.New(500, "TRANSFER_ERROR", "收款地址错误")
+	}
+
+	user, err := uuc.repo.GetUserById(userId)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == user {
+		return nil, errors.New(500, "TRANSFER_ERROR", "用户不存在")
+	}
+
+	var toUser *User
+	toUser, err = uuc.repo.GetUserByAddress(toAddress)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == toUser {
+		return nil, errors.New(500, "TRANSFER_ERROR", "收款地址不存在")
+	}
+
+	if toUser.ID == user.ID {
+		return nil, errors.New(500, "TRANSFER_ERROR", "不能划转给自己")
+	}
+
+	cfg := uuc.GetTransferConfig()
+	if 0 < cfg.MinAmount.Sign() && amount.LessThan(cfg.MinAmount) {
+		return nil, errors.New(500, "TRANSFER_ERROR", "低于最低划转金额")
+	}
+
+	fee := cfg.TransferFee(amount)
+	amountRel := amount.Sub(fee)
+	if 0 >= amountRel.Sign() {
+		return nil, errors.New(500, "TRANSFER_ERROR", "划转金额不足以支付手续费")
+	}
+
+	if amount.GreaterThan(user.Amount) {
+		return nil, errors.New(500, "TRANSFER_ERROR", "余额不足")
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 锁转出方用户行后再算额度，并发划转不会都按旧的合计通过
+		balance, err := uuc.repo.LockUserAmount(ctx, user.ID)
+		if nil != err {
+			return err
+		}
+
+		if amount.GreaterThan(balance) {
+			return errors.New(500, "TRANSFER_ERROR", "余额不足")
+		}
+
+		if 0 < cfg.DailyLimit.Sign() || 0 < cfg.DailyCount {
+			dayAmount, dayCount, err := uuc.repo.GetUserTransferTotal(ctx, user.ID, time.Now().UTC().Add(-24*time.Hour))
+			if nil != err {
+				return err
+			}
+
+			if 0 < cfg.DailyLimit.Sign() && dayAmount.Add(amount).GreaterThan(cfg.DailyLimit) {
+				return errors.New(500, "TRANSFER_ERROR", "超过24小时划转额度")
+			}
+
+			if 0 < cfg.DailyCount && dayCount+1 > cfg.DailyCount {
+				return errors.New(500, "TRANSFER_ERROR", "超过24小时划转笔数")
+			}
+		}
+
+		return uuc.repo.AmountTo(ctx, user.ID, toUser.ID, user.Address, toUser.Address, amount, amountRel)
+	}); nil != err {
+		return nil, err
+	}
+
+	return &pb.TransferReply{
+		Amount:    amount.String(),
+		RelAmount: amountRel.String(),
+		Fee:       fee.String(),
+	}, nil
+}
+
+// Withdraw 提现申请，按手续费计算到账金额，扣余额和记录在一个事务内，小额且未命中风控自动通过
+func (uuc *UserUseCase) Withdraw(ctx context.Context, userId uint64, amount money.Money, address string, chain string) (*pb.WithdrawReply, error) {
+	if 0 >= amount.Sign() {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现金额错误")
+	}
+
+	if "" == address {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现地址错误")
+	}
+
+	user, err := uuc.repo.GetUserById(userId)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == user {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "用户不存在")
+	}
+
+	if amount.GreaterThan(user.Amount) {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "余额不足")
+	}
+
+	amountRel := amount.Sub(uuc.WithdrawFee(user, amount))
+	if 0 >= amountRel.Sign() {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现金额不足以支付手续费")
+	}
+
+	risk := uuc.GetWithdrawRisk()
+	if 0 < risk.MinAmount.Sign() && amount.LessThan(risk.MinAmount) {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "低于最低提现金额")
+	}
+
+	status := WithdrawStatusRequested
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		// 锁用户行后再算额度，并发申请不会都按旧的已提现金额通过
+		balance, err := uuc.repo.LockUserAmount(ctx, userId)
+		if nil != err {
+			return err
+		}
+
+		if amount.GreaterThan(balance) {
+			return errors.New(500, "WITHDRAW_ERROR", "余额不足")
+		}
+
+		remark, err := uuc.withdrawReview(ctx, risk, userId, amount, amountRel, address)
+		if nil != err {
+			return err
+		}
+
+		if "" == remark && 0 < risk.AutoApproveAmount.Sign() && 0 >= amount.Cmp(risk.AutoApproveAmount) {
+			status = WithdrawStatusApproved
+		}
+
+		return uuc.repo.Withdraw(ctx, userId, amount, amountRel, address, chain, status, remark)
+	}); nil != err {
+		return nil, err
+	}
+
+	return &pb.WithdrawReply{
+		Amount:    amount.String(),
+		RelAmount: amountRel.String(),
+		Fee:       amount.Sub(amountRel).String(),
+		Status:    status,
+	}, nil
+}
+
+// WithdrawList 用户自己的提现记录，审核备注只在驳回时返回
+func (uuc *UserUseCase) WithdrawList(ctx context.Context, userId uint64, req *pb.WithdrawListRequest) (*pb.WithdrawListReply, error) {
+	var (
+		withdraws []*Withdraw
+		count     int64
+		err       error
+	)
+
+	res := &pb.WithdrawListReply{
+		List: make([]*pb.WithdrawListReply_List, 0),
+	}
+
+	withdraws, err, count = uuc.repo.GetWithdrawPageByUserId(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, userId)
+	if nil != err {
+		return res, err
+	}
+	res.Count = uint64(count)
+
+	for _, v := range withdraws {
+		remark := ""
+		if WithdrawStatusRejected == v.Status {
+			remark = v.Remark
+		}
+
+		res.List = append(res.List, &pb.WithdrawListReply_List{
+			Id:        v.ID,
+			Amount:    v.Amount.String(),
+			RelAmount: v.RelAmount.String(),
+			Fee:       v.Amount.Sub(v.RelAmount).String(),
+			Address:   v.Address,
+			Chain:     v.Chain,
+			Status:    v.Status,
+			TxHash:    v.TxHash,
+			Remark:    remark,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminWithdrawList(ctx context.Context, req *pb.AdminWithdrawListRequest) (*pb.AdminWithdrawListReply, error) {
+	var (
+		withdraws []*Withdraw
+		count     int64
+		err       error
+	)
+
+	res := &pb.AdminWithdrawListReply{
+		List: make([]*pb.AdminWithdrawListReply_List, 0),
+	}
+
+	status := WithdrawStatusRequested
+	if "" != req.Status {
+		status = req.Status
+	}
+
+	withdraws, err, count = uuc.repo.GetWithdrawPage(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, status, req.Address)
+	if nil != err {
+		return res, nil
+	}
+	res.Count = uint64(count)
+
+	for _, v := range withdraws {
+		res.List = append(res.List, &pb.AdminWithdrawListReply_List{
+			Id:        v.ID,
+			UserId:    v.UserId,
+			Address:   v.Address,
+			Chain:     v.Chain,
+			Amount:    v.Amount.String(),
+			RelAmount: v.RelAmount.String(),
+			Status:    v.Status,
+			Remark:    v.Remark,
+			TxHash:    v.TxHash,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+func (uuc *UserUseCase) AdminWithdrawHandle(ctx context.Context, req *pb.AdminWithdrawHandleRequest) (*pb.AdminWithdrawHandleReply, error) {
+	var (
+		withdraw *Withdraw
+		err      error
+	)
+
+	res := &pb.AdminWithdrawHandleReply{}
+
+	withdraw, err = uuc.repo.GetWithdrawById(req.SendBody.Id)
+	if nil != err {
+		return res, err
+	}
+
+	if nil == withdraw {
+		return res, errors.New(500, "WITHDRAW_ERROR", "提现记录不存在")
+	}
+
+	switch req.SendBody.Action {
+	case "approve":
+		if WithdrawStatusRequested != withdraw.Status {
+			return res, errors.New(500, "WITHDRAW_ERROR", "提现状态不允许审核")
+		}
+
+		err = uuc.repo.UpdateWithdrawStatus(ctx, withdraw.ID, withdraw.Status, WithdrawStatusApproved, req.SendBody.Remark)
+		if nil != err {
+			return res, err
+		}
+
+	case "reject":
+		// 已开始发放的不能驳回
+		if WithdrawStatusRequested != withdraw.Status && WithdrawStatusApproved != withdraw.Status {
+			return res, errors.New(500, "WITHDRAW_ERROR", "提现状态不允许驳回")
+		}
+
+		if "" == req.SendBody.Remark {
+			return res, errors.New(500, "WITHDRAW_ERROR", "请填写驳回原因")
+		}
+
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err = uuc.repo.UpdateWithdrawStatus(ctx, withdraw.ID, withdraw.Status, WithdrawStatusRejected, req.SendBody.Remark)
+			if nil != err {
+				return err
+			}
+
+			return uuc.repo.RefundWithdraw(ctx, withdraw.UserId, withdraw.Amount, withdraw.RelAmount, withdraw.Address)
+		}); nil != err {
+			return res, err
+		}
+
+	case "refund":
+		// 链上回执失败的没有出款，核对后退回余额
+		if WithdrawStatusFailed != withdraw.Status {
+			return res, errors.New(500, "WITHDRAW_ERROR", "提现状态不允许退款")
+		}
+
+		if "" == req.SendBody.Remark {
+			return res, errors.New(500, "WITHDRAW_ERROR", "请填写退款原因")
+		}
+
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			err = uuc.repo.UpdateWithdrawStatus(ctx, withdraw.ID, withdraw.Status, WithdrawStatusRejected, req.SendBody.Remark)
+			if nil != err {
+				return err
+			}
+
+			return uuc.repo.RefundWithdraw(ctx, withdraw.UserId, withdraw.Amount, withdraw.RelAmount, withdraw.Address)
+		}); nil != err {
+			return res, err
+		}
+
+	case "fail":
+		// 发放中、已广播卡住的，链上核对未出款后置为失败（核对在service）
+		if WithdrawStatusDoing != withdraw.Status && WithdrawStatusSending != withdraw.Status {
+			return res, errors.New(500, "WITHDRAW_ERROR", "提现状态不允许置为失败")
+		}
+
+		if "" == req.SendBody.Remark {
+			return res, errors.New(500, "WITHDRAW_ERROR", "请填写失败原因")
+		}
+
+		err = uuc.repo.UpdateWithdrawStatus(ctx, withdraw.ID, withdraw.Status, WithdrawStatusFailed, req.SendBody.Remark)
+		if nil != err {
+			return res, err
+		}
+
+	case "resign":
+		// 链上核对未出款的，退回待发放重新签名（核对在service）
+		if WithdrawStatusDoing != withdraw.Status && WithdrawStatusSending != withdraw.Status && WithdrawStatusFailed != withdraw.Status {
+			return res, errors.New(500, "WITHDRAW_ERROR", "提现状态不允许重新签名")
+		}
+
+		if "" == req.SendBody.Remark {
+			return res, errors.New(500, "WITHDRAW_ERROR", "请填写重新签名原因")
+		}
+
+		err = uuc.repo.ResetWithdrawTx(ctx, withdraw.ID, withdraw.Status, req.SendBody.Remark)
+		if nil != err {
+			return res, err
+		}
+
+	default:
+		return res, errors.New(500, "WITHDRAW_ERROR", "操作类型错误")
+	}
+
+	return res, nil
+}
+
+// GetWithdrawById .
+func (uuc *UserUseCase) GetWithdrawById(id uint64) (*Withdraw, error) {
+	return uuc.repo.GetWithdrawById(id)
+}
+
+// AdminLedgerOpen 首次上线记期初：余额和账本的差额记一笔，已有账户的用户也补齐上线前的余额
+func (uuc *UserUseCase) AdminLedgerOpen(ctx context.Context, req *pb.AdminLedgerOpenRequest) (*pb.AdminLedgerOpenReply, error) {
+	res := &pb.AdminLedgerOpenReply{}
+
+	users, err := uuc.repo.GetUsersWithoutOpening(1000)
+	if nil != err {
+		return res, err
+	}
+
+	for _, user := range users {
+		var opened bool
+		if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+			opened, err = uuc.repo.OpenLedgerUserBalance(ctx, user.ID)
+			return err
+		}); nil != err {
+			fmt.Println("账本期初失败：", user.ID, err)
+			continue
+		}
+
+		if opened {
+			res.Opened++
+		}
+	}
+
+	return res, nil
+}
+
+// AdminLedgerVerify 核对 user.amount 和账本余额
+func (uuc *UserUseCase) AdminLedgerVerify(ctx context.Context, req *pb.AdminLedgerVerifyRequest) (*pb.AdminLedgerVerifyReply, error) {
+	var (
+		mismatches []*LedgerMismatch
+		count      int64
+		err        error
+	)
+
+	res := &pb.AdminLedgerVerifyReply{
+		List: make([]*pb.AdminLedgerVerifyReply_List, 0),
+	}
+
+	mismatches, err, count = uuc.repo.GetLedgerMismatchPage(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	})
+	if nil != err {
+		return res, err
+	}
+	res.Count = uint64(count)
+
+	for _, v := range mismatches {
+		res.List = append(res.List, &pb.AdminLedgerVerifyReply_List{
+			UserId:  v.UserId,
+			Address: v.Address,
+			Amount:  v.Amount.String(),
+			Balance: v.Balance.String(),
+		})
+	}
+
+	return res, nil
+}
+
+// reconcileExpected 按来源合计重算余额
+func reconcileExpected(userId uint64, rewardTotals map[uint64]map[uint64]money.Money, transferIn, deposits map[uint64]money.Money) (money.Money, []*ReconcileReason) {
+	expected := money.Zero()
+	reasons := make([]*ReconcileReason, 0)
+
+	if amount, ok := deposits[userId]; ok {
+		expected = expected.Add(amount)
+		reasons = append(reasons, &ReconcileReason{Source: ReconcileSourceDeposit, Reason: RewardReasonDeposit, Amount: amount})
+	}
+
+	if amount, ok := transferIn[userId]; ok {
+		expected = expected.Add(amount)
+		reasons = append(reasons, &ReconcileReason{Source: ReconcileSourceTransferIn, Reason: RewardReasonTransfer, Amount: amount})
+	}
+
+	rewardReasons := make([]uint64, 0)
+	for reason := range rewardTotals[userId] {
+		if _, ok := reconcileRewardSign[reason]; ok {
+			rewardReasons = append(rewardReasons, reason)
+		}
+	}
+	sort.Slice(rewardReasons, func(i, j int) bool {
+		return rewardReasons[i] < rewardReasons[j]
+	})
+
+	for _, reason := range rewardReasons {
+		amount := rewardTotals[userId][reason]
+		if 0 > reconcileRewardSign[reason] {
+			amount = amount.Neg()
+		}
+
+		expected = expected.Add(amount)
+		reasons = append(reasons, &ReconcileReason{Source: ReconcileSourceReward, Reason: reason, Amount: amount})
+	}
+
+	return expected, reasons
+}
+
+// AdminReconcile 按充值记录和 reward 流水重算每个用户余额，和 user.amount 不一致的写入对账表
+func (uuc *UserUseCase) AdminReconcile(ctx context.Context, req *pb.AdminReconcileRequest) (*pb.AdminReconcileReply, error) {
+	var (
+		users        []*User
+		rewardTotals map[uint64]map[uint64]money.Money
+		transferIn   map[uint64]money.Money
+		deposits     map[uint64]money.Money
+		err          error
+	)
+
+	// 先取用户余额再取流水，期间新写入的流水会表现为差异，下次对账消失
+	users, err = uuc.repo.GetAllUsers()
+	if nil != err {
+		return nil, err
+	}
+
+	rewardTotals, err = uuc.repo.GetRewardTotalsByUser()
+	if nil != err {
+		return nil, err
+	}
+
+	transferIn, err = uuc.repo.GetTransferInTotalsByUser()
+	if nil != err {
+		return nil, err
+	}
+
+	deposits, err = uuc.repo.GetDepositTotalsByUser()
+	if nil != err {
+		return nil, err
+	}
+
+	runId := time.Now().Unix()
+	list := make([]*ReconcileDiscrepancy, 0)
+	for _, user := range users {
+		expected, reasons := reconcileExpected(user.ID, rewardTotals, transferIn, deposits)
+		if 0 == user.Amount.Cmp(expected) {
+			continue
+		}
+
+		list = append(list, &ReconcileDiscrepancy{
+			RunId:    runId,
+			UserId:   user.ID,
+			Address:  user.Address,
+			Amount:   user.Amount,
+			Expected: expected,
+			Diff:     user.Amount.Sub(expected),
+			Reasons:  reasons,
+		})
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		return uuc.repo.CreateReconcileDiscrepancies(ctx, runId, list)
+	}); nil != err {
+		return nil, err
+	}
+
+	fmt.Println("对账完成：", runId, len(users), len(list))
+	return &pb.AdminReconcileReply{
+		RunId: runId,
+		Users: uint64(len(users)),
+		Count: uint64(len(list)),
+	}, nil
+}
+
+func (uuc *UserUseCase) AdminReconcileList(ctx context.Context, req *pb.AdminReconcileListRequest) (*pb.AdminReconcileListReply, error) {
+	var (
+		discrepancies []*ReconcileDiscrepancy
+		count         int64
+		err           error
+	)
+
+	res := &pb.AdminReconcileListReply{
+		List:  make([]*pb.AdminReconcileListReply_List, 0),
+		RunId: req.RunId,
+	}
+
+	if 0 >= res.RunId {
+		res.RunId, err = uuc.repo.GetReconcileLastRunId()
+		if nil != err {
+			return res, err
+		}
+	}
+
+	discrepancies, err, count = uuc.repo.GetReconcileDiscrepancyPage(&Pagination{
+		PageNum:  int(req.Page),
+		PageSize: 10,
+	}, res.RunId)
+	if nil != err {
+		return res, err
+	}
+	res.Count = uint64(count)
+
+	for _, v := range discrepancies {
+		reasons := make([]*pb.AdminReconcileListReply_Reason, 0)
+		for _, r := range v.Reasons {
+			reasons = append(reasons, &pb.AdminReconcileListReply_Reason{
+				Source: r.Source,
+				Reason: r.Reason,
+				Amount: r.Amount.String(),
+			})
+		}
+
+		res.List = append(res.List, &pb.AdminReconcileListReply_List{
+			UserId:    v.UserId,
+			Address:   v.Address,
+			Amount:    v.Amount.String(),
+			Expected:  v.Expected.String(),
+			Diff:      v.Diff.String(),
+			Reasons:   reasons,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	return res, nil
+}
+
+// AdminReconcileEntries 用户参与对账的全部流水，按当前数据重算
+func (uuc *UserUseCase) AdminReconcileEntries(ctx context.Context, req *pb.AdminReconcileEntriesRequest) (*pb.AdminReconcileEntriesReply, error) {
+	var (
+		user    *User
+		entries []*ReconcileEntry
+		err     error
+	)
+
+	res := &pb.AdminReconcileEntriesReply{
+		List: make([]*pb.AdminReconcileEntriesReply_List, 0),
+	}
+
+	user, err = uuc.repo.GetUserById(req.UserId)
+	if nil != err {
+		return res, err
+	}
+	if nil == user {
+		return res, errors.New(500, "USER_ERROR", "用户不存在")
+	}
+
+	entries, err = uuc.repo.GetReconcileEntries(user.ID, user.Address)
+	if nil != err {
+		return res, err
+	}
+
+	expected := money.Zero()
+	for _, v := range entries {
+		expected = expected.Add(v.Amount)
+		res.List = append(res.List, &pb.AdminReconcileEntriesReply_List{
+			Source:    v.Source,
+			Id:        v.ID,
+			Reason:    v.Reason,
+			Amount:    v.Amount.String(),
+			Address:   v.Address,
+			CreatedAt: v.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+		})
+	}
+
+	res.Amount = user.Amount.String()
+	res.Expected = expected.String()
+	return res, nil
+}
+
+func (uuc *UserUseCase) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*Withdraw, error) {
+	return uuc.repo.GetWithdrawPassOrRewardedFirst(ctx)
+}
 
 func (uuc *UserUseCase) GetUserByUserIds(userIds ...uint64) (map[uint64]*User, error) {
 	return uuc.repo.GetUserByUserIds(userIds...)
 }
 
-func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, id uint64) (*Withdraw, error) {
-	return uuc.repo.UpdateWithdraw(ctx, id, "doing")
+// UpdateWithdrawDoing 按原状态修改，审核驳回和发放不会同时成功
+func (uuc *UserUseCase) UpdateWithdrawDoing(ctx context.Context, withdraw *Withdraw) error {
+	return uuc.repo.UpdateWithdrawStatus(ctx, withdraw.ID, withdraw.Status, WithdrawStatusDoing, withdraw.Remark)
+}
+
+// RejectWithdrawBelowMin 到账金额太小不上链，驳回并退回余额
+func (uuc *UserUseCase) RejectWithdrawBelowMin(ctx context.Context, withdraw *Withdraw) error {
+	return uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		err := uuc.repo.UpdateWithdrawStatus(ctx, withdraw.ID, withdraw.Status, WithdrawStatusRejected, "到账金额低于最低发放金额，已退回余额")
+		if nil != err {
+			return err
+		}
+
+		return uuc.repo.RefundWithdraw(ctx, withdraw.UserId, withdraw.Amount, withdraw.RelAmount, withdraw.Address)
+	})
+}
+
+// UpdateWithdrawRetry 签名前失败，交易未发出，退回待发放
+func (uuc *UserUseCase) UpdateWithdrawRetry(ctx context.Context, withdraw *Withdraw) error {
+	return uuc.repo.UpdateWithdrawStatus(ctx, withdraw.ID, WithdrawStatusDoing, WithdrawStatusApproved, withdraw.Remark)
+}
+
+// UpdateWithdrawSigned 广播前保存签名交易，重试时重发同一笔
+func (uuc *UserUseCase) UpdateWithdrawSigned(ctx context.Context, id uint64, txHash string, nonce uint64, rawTx string) error {
+	return uuc.repo.UpdateWithdrawSigned(ctx, id, txHash, nonce, rawTx)
+}
+
+// UpdateWithdrawSending 交易已广播，记录哈希和nonce，等待回执
+func (uuc *UserUseCase) UpdateWithdrawSending(ctx context.Context, id uint64, txHash string, nonce uint64) error {
+	return uuc.repo.UpdateWithdrawSending(ctx, id, txHash, nonce)
+}
+
+// UpdateWithdrawConfirmed 回执成功且达到确认数
+func (uuc *UserUseCase) UpdateWithdrawConfirmed(ctx context.Context, id uint64, blockNumber uint64) error {
+	return uuc.repo.UpdateWithdrawResult(ctx, id, WithdrawStatusConfirmed, blockNumber)
+}
+
+// WithdrawSendFailed 交易发不出去或已被丢弃，发放中、已广播的置为失败，人工退款或重新签名
+func (uuc *UserUseCase) WithdrawSendFailed(ctx context.Context, withdraw *Withdraw, remark string) error {
+	return uuc.repo.UpdateWithdrawStatus(ctx, withdraw.ID, withdraw.Status, WithdrawStatusFailed, remark)
+}
+
+// UpdateWithdrawFailed 回执失败，链上转账未执行，需人工处理
+func (uuc *UserUseCase) UpdateWithdrawFailed(ctx context.Context, id uint64, blockNumber uint64) error {
+	return uuc.repo.UpdateWithdrawResult(ctx, id, WithdrawStatusFailed, blockNumber)
+}
+
+// GetWithdrawsPassOrRewarded 待发放的提现，按id顺序
+func (uuc *UserUseCase) GetWithdrawsPassOrRewarded(limit int) ([]*Withdraw, error) {
+	return uuc.repo.GetWithdrawsPassOrRewarded(limit)
+}
+
+// GetWithdrawBatchSize 每批发放笔数
+func (uuc *UserUseCase) GetWithdrawBatchSize() int {
+	var (
+		configs   []*Config
+		batchSize = 50
+	)
+
+	// 配置
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_batch_size")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_batch_size" == vConfig.KeyName {
+				tmp, err := strconv.ParseInt(vConfig.Value, 10, 64)
+				if nil == err && 0 < tmp {
+					batchSize = int(tmp)
+				}
+			}
+		}
+	}
+
+	return batchSize
+}
+
+func (uuc *UserUseCase) GetWithdrawsDoing(limit int) ([]*Withdraw, error) {
+	return uuc.repo.GetWithdrawsByStatus("doing", limit)
+}
+
+func (uuc *UserUseCase) GetWithdrawsSending(limit int) ([]*Withdraw, error) {
+	return uuc.repo.GetWithdrawsByStatus(WithdrawStatusSending, limit)
+}
+
+// HotWalletConfig 热钱包阈值，低于阈值暂停提现
+type HotWalletConfig struct {
+	MinToken money.Money
+	MinGas   money.Money
+	Paused   bool
+}
+
+// GetHotWalletConfig 热钱包余额阈值和后台暂停开关
+func (uuc *UserUseCase) GetHotWalletConfig() *HotWalletConfig {
+	var (
+		configs []*Config
+		res     = &HotWalletConfig{MinGas: money.FromWei(big.NewInt(5), 3)} // 默认0.005
+	)
+
+	// 配置
+	configs, _ = uuc.repo.GetConfigByKeys("hot_wallet_min_token", "hot_wallet_min_gas", "withdraw_paused")
+	if nil != configs {
+		for _, vConfig := range configs {
+			switch vConfig.KeyName {
+			case "hot_wallet_min_token":
+				tmp, err := money.Parse(vConfig.Value)
+				if nil == err {
+					res.MinToken = tmp
+				}
+			case "hot_wallet_min_gas":
+				tmp, err := money.Parse(vConfig.Value)
+				if nil == err {
+					res.MinGas = tmp
+				}
+			case "withdraw_paused":
+				res.Paused = "1" == vConfig.Value
+			}
+		}
+	}
+
+	return res
+}
+
+// GetWithdrawConfirmations 提现回执确认数
+func (uuc *UserUseCase) GetWithdrawConfirmations() uint64 {
+	var (
+		configs       []*Config
+		confirmations = uint64(15)
+	)
+
+	// 配置
+	configs, _ = uuc.repo.GetConfigByKeys("withdraw_confirmations")
+	if nil != configs {
+		for _, vConfig := range configs {
+			if "withdraw_confirmations" == vConfig.KeyName {
+				tmp, err := strconv.ParseUint(vConfig.Value, 10, 64)
+				if nil == err {
+					confirmations = tmp
+				}
+			}
+		}
+	}
+
+	return confirmations
+}
+
+// loginMessage 钱包登录待签名消息，服务端按地址和nonce重新拼出后验签
+func loginMessage(address string, nonce string) string {
+	return fmt.Sprintf("Welcome to cardbinance!\n\nSign this message to log in.\n\nAddress: %s\nNonce: %s", address, nonce)
+}
+
+// LoginNonce 生成一次性nonce，60秒内重复请求返回同一个
+func (uuc *UserUseCase) LoginNonce(ctx context.Context, address string) (*pb.LoginNonceReply, error) {
+	nonce, err := uuc.repo.SetNonceByAddress(ctx, address)
+	if nil != err {
+		return nil, errors.New(500, "LOGIN_ERROR", "获取签名信息失败")
+	}
+
+	return &pb.LoginNonceReply{
+		Nonce:   nonce,
+		Message: loginMessage(address, strconv.FormatInt(nonce, 10)),
+	}, nil
+}
+
+// LoginMessage 取出nonce，返回应被签名的消息；验签通过后再调用 ConsumeLoginNonce 作废
+func (uuc *UserUseCase) LoginMessage(ctx context.Context, address string) (string, string, error) {
+	nonce, err := uuc.repo.GetWalletNonce(ctx, address)
+	if nil != err {
+		return "", "", errors.New(500, "LOGIN_ERROR", "获取签名信息失败")
+	}
+
+	if "" == nonce {
+		return "", "", errors.New(500, "LOGIN_ERROR", "签名已过期，请重新获取")
+	}
+
+	return loginMessage(address, nonce), nonce, nil
+}
+
+// ConsumeLoginNonce 验签通过后作废nonce，同一签名并发提交只有一个成功
+func (uuc *UserUseCase) ConsumeLoginNonce(ctx context.Context, address string, nonce string) error {
+	deleted, err := uuc.repo.DeleteWalletNonce(ctx, address, nonce)
+	if nil != err {
+		return errors.New(500, "LOGIN_ERROR", "获取签名信息失败")
+	}
+
+	if !deleted {
+		return errors.New(500, "LOGIN_ERROR", "签名已过期，请重新获取")
+	}
+
+	return nil
+}
+
+// userToken 签发用户token
+func userToken(user *User, ca string) (string, error) {
+	claims := auth.CustomClaims{
+		UserId:   user.ID,
+		UserType: "user",
+		RegisteredClaims: jwt2.RegisteredClaims{
+			NotBefore: jwt2.NewNumericDate(time.Now()),                     // 签名的生效时间
+			ExpiresAt: jwt2.NewNumericDate(time.Now().Add(48 * time.Hour)), // 2天过期
+			Issuer:    "game",
+		},
+	}
+
+	return auth.CreateToken(claims, ca)
+}
+
+// UserLogin 验签通过后签发用户token，未注册的走 Register
+func (uuc *UserUseCase) UserLogin(ctx context.Context, address string, ca string) (*pb.LoginReply, error) {
+	user, err := uuc.repo.GetUserByAddress(address)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == user {
+		return nil, errors.New(500, "USER_NOT_REGISTERED", "用户未注册")
+	}
+
+	if 1 == user.IsDelete {
+		return nil, errors.New(500, "LOGIN_ERROR", "用户已禁用")
+	}
+
+	token, err := userToken(user, ca)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LoginReply{Token: token}, nil
+}
+
+var registerLock sync.Mutex
+
+// getInviter 邀请人，0x开头按地址查，否则按邀请码（用户id）查
+func (uuc *UserUseCase) getInviter(inviter string) (*User, error) {
+	inviter = strings.TrimSpace(inviter)
+	if "" == inviter {
+		return nil, nil
+	}
+
+	if strings.HasPrefix(strings.ToLower(inviter), "0x") {
+		return uuc.repo.GetUserByAddress(inviter)
+	}
+
+	inviterId, err := strconv.ParseUint(inviter, 10, 64)
+	if nil != err || 0 >= inviterId {
+		return nil, nil
+	}
+
+	return uuc.repo.GetUserById(inviterId)
 }
 
-func (uuc *UserUseCase) UpdateWithdrawSuccess(ctx context.Context, id uint64) (*Withdraw, error) {
-	return uuc.repo.UpdateWithdraw(ctx, id, "success")
+// Register 验签通过后注册，用户和推荐关系在一个事务内创建，之后认领该地址之前的充值
+func (uuc *UserUseCase) Register(ctx context.Context, address string, inviter string, ca string) (*pb.RegisterReply, error) {
+	registerLock.Lock()
+	defer registerLock.Unlock()
+
+	var (
+		user                 *User
+		inviterUser          *User
+		inviterUserRecommend *UserRecommend
+		err                  error
+	)
+
+	user, err = uuc.repo.GetUserByAddress(address)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil != user {
+		return nil, errors.New(500, "REGISTER_ERROR", "地址已注册")
+	}
+
+	if strings.EqualFold(strings.TrimSpace(inviter), address) {
+		return nil, errors.New(500, "REGISTER_ERROR", "不能邀请自己")
+	}
+
+	inviterUser, err = uuc.getInviter(inviter)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == inviterUser {
+		return nil, errors.New(500, "REGISTER_ERROR", "邀请人不存在")
+	}
+
+	if 1 == inviterUser.IsDelete {
+		return nil, errors.New(500, "REGISTER_ERROR", "邀请人已禁用")
+	}
+
+	// 邀请人没有推荐关系说明数据不完整，不能挂在下面
+	inviterUserRecommend, err = uuc.repo.GetUserRecommendByUserId(inviterUser.ID)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == inviterUserRecommend {
+		return nil, errors.New(500, "REGISTER_ERROR", "邀请人推荐关系不存在")
+	}
+
+	if err = uuc.tx.ExecTx(ctx, func(ctx context.Context) error { // 事务
+		user, err = uuc.repo.CreateUser(ctx, &User{Address: address})
+		if nil != err {
+			return err
+		}
+
+		// 推荐链上出现自己或邀请人即成环
+		if user.ID == inviterUser.ID {
+			return errors.New(500, "REGISTER_ERROR", "不能邀请自己")
+		}
+
+		for _, tmp := range strings.Split(inviterUserRecommend.RecommendCode, "D") {
+			tmpUserId, _ := strconv.ParseUint(tmp, 10, 64)
+			if tmpUserId == user.ID || tmpUserId == inviterUser.ID {
+				return errors.New(500, "REGISTER_ERROR", "推荐关系成环")
+			}
+		}
+
+		_, err = uuc.repo.CreateUserRecommend(ctx, user.ID, &UserRecommend{
+			UserId:        inviterUser.ID,
+			RecommendCode: inviterUserRecommend.RecommendCode,
+		})
+		return err
+	}); nil != err {
+		return nil, err
+	}
+
+	err = uuc.ClaimPendingDeposits(ctx, user.ID, address)
+	if nil != err {
+		fmt.Println("认领充值失败：", address, err)
+	}
+
+	token, err := userToken(user, ca)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RegisterReply{Token: token}, nil
 }
 
 func (uuc *UserUseCase) AdminLogin(ctx context.Context, req *pb.AdminLoginRequest, ca string) (*pb.AdminLoginReply, error) {
@@ -1259,12 +3417,13 @@ func (uuc *UserUseCase) AdminRewardList(ctx context.Context, req *pb.AdminReward
 		}
 
 		res.Rewards = append(res.Rewards, &pb.AdminRewardListReply_List{
-			CreatedAt:  vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
-			Amount:     fmt.Sprintf("%.2f", vUserReward.Amount),
-			Address:    tmpUser,
-			Reason:     vUserReward.Reason,
-			AddressTwo: vUserReward.Address,
-			One:        vUserReward.One,
+			CreatedAt:   vUserReward.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
+			Amount:      vUserReward.Amount.StringFixed(2),
+			Address:     tmpUser,
+			Reason:      vUserReward.Reason,
+			AddressTwo:  vUserReward.Address,
+			One:         vUserReward.One,
+			ReasonLabel: RewardReasonLabel(vUserReward.Reason),
 		})
 	}
 
@@ -1388,7 +3547,7 @@ func (uuc *UserUseCase) AdminUserList(ctx context.Context, req *pb.AdminUserList
 			UserId:             vUsers.ID,
 			CreatedAt:          vUsers.CreatedAt.Add(8 * time.Hour).Format("2006-01-02 15:04:05"),
 			Address:            vUsers.Address,
-			Amount:             fmt.Sprintf("%.2f", vUsers.Amount),
+			Amount:             vUsers.Amount.StringFixed(2),
 			Vip:                vUsers.Vip,
 			CanVip:             vUsers.CanVip,
 			VipThree:           vUsers.VipThree,
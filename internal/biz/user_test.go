@@ -0,0 +1,369 @@
+package biz
+
+import (
+	"cardbinance/internal/pkg/money"
+	"context"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fakeUserRepo 只实现测试用到的方法，其余方法调用会panic
+type fakeUserRepo struct {
+	UserRepo
+	configs []*Config
+	users   []*User
+
+	// 划转
+	dayAmount money.Money
+	dayCount  int64
+	transfers int
+
+	// 注册
+	recommends []*UserRecommend
+}
+
+func (r *fakeUserRepo) GetUserRecommendByUserId(userId uint64) (*UserRecommend, error) {
+	for _, v := range r.recommends {
+		if userId == v.UserId {
+			return v, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *fakeUserRepo) CreateUser(ctx context.Context, uc *User) (*User, error) {
+	user := &User{ID: uint64(len(r.users)) + 1, Address: uc.Address}
+	r.users = append(r.users, user)
+	return user, nil
+}
+
+// CreateUserRecommend 推荐码拼接同 data 层
+func (r *fakeUserRepo) CreateUserRecommend(ctx context.Context, userId uint64, recommendUser *UserRecommend) (*UserRecommend, error) {
+	recommend := &UserRecommend{UserId: userId, RecommendCode: recommendUser.RecommendCode + "D" + strconv.FormatUint(recommendUser.UserId, 10)}
+	r.recommends = append(r.recommends, recommend)
+	return recommend, nil
+}
+
+func (r *fakeUserRepo) GetDepositPendingsByAddress(address string, status string) ([]*DepositPending, error) {
+	return nil, nil
+}
+
+func (r *fakeUserRepo) GetUserById(userId uint64) (*User, error) {
+	for _, v := range r.users {
+		if userId == v.ID {
+			return v, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *fakeUserRepo) GetUserByAddress(address string) (*User, error) {
+	for _, v := range r.users {
+		if address == v.Address {
+			return v, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *fakeUserRepo) LockUserAmount(ctx context.Context, userId uint64) (money.Money, error) {
+	user, _ := r.GetUserById(userId)
+	return user.Amount, nil
+}
+
+func (r *fakeUserRepo) GetUserTransferTotal(ctx context.Context, userId uint64, since time.Time) (money.Money, int64, error) {
+	return r.dayAmount, r.dayCount, nil
+}
+
+func (r *fakeUserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, fromAddress, toAddress string, amount, amountRel money.Money) error {
+	r.transfers++
+	return nil
+}
+
+func (r *fakeUserRepo) GetConfigByKeys(keys ...string) ([]*Config, error) {
+	res := make([]*Config, 0)
+	for _, v := range r.configs {
+		for _, key := range keys {
+			if key == v.KeyName {
+				res = append(res, v)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// fakeTx 直接执行，不开事务
+type fakeTx struct{}
+
+func (fakeTx) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newTestUserUseCase(repo UserRepo) *UserUseCase {
+	return NewUserUseCase(repo, fakeTx{}, log.DefaultLogger)
+}
+
+// testConfigs key, value 成对
+func testConfigs(kv ...string) []*Config {
+	res := make([]*Config, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		res = append(res, &Config{KeyName: kv[i], Value: kv[i+1]})
+	}
+
+	return res
+}
+
+func mustParse(t *testing.T, s string) money.Money {
+	t.Helper()
+	m, err := money.Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", s, err)
+	}
+	return m
+}
+
+func TestTokenToBalance(t *testing.T) {
+	tests := []struct {
+		value    string
+		decimals uint8
+		balance  string
+		whole    uint64
+	}{
+		{value: "0", decimals: 18, balance: "0", whole: 0},
+		{value: "-1", decimals: 18, balance: "0", whole: 0},
+		{value: "1000000", decimals: 6, balance: "1", whole: 1},
+		{value: "1234567", decimals: 6, balance: "1.234567", whole: 1},
+		{value: "999999", decimals: 6, balance: "0.999999", whole: 0},
+		{value: "1500000000000000000", decimals: 18, balance: "1.5", whole: 1},
+		{value: "100000000000000000000", decimals: 18, balance: "100", whole: 100},
+		{value: "1", decimals: 18, balance: "0.000000000000000001", whole: 0},
+		{value: "2500000000000000000000000", decimals: 24, balance: "2.5", whole: 2},
+		// 超过18位的部分截断
+		{value: "1234567", decimals: 24, balance: "0.000000000000000001", whole: 0},
+		{value: "9223372036854775807000000", decimals: 6, balance: "9223372036854775807", whole: math.MaxInt64},
+		// 整数部分超出bigint按MaxInt64截断，余额不截断
+		{value: "9223372036854775808000000", decimals: 6, balance: "9223372036854775808", whole: math.MaxInt64},
+		{value: "100000000000000000000000000000000000000", decimals: 18, balance: "100000000000000000000", whole: math.MaxInt64},
+	}
+
+	for _, tt := range tests {
+		v, _ := new(big.Int).SetString(tt.value, 10)
+		balance, whole := TokenToBalance(v, tt.decimals)
+		if balance.String() != tt.balance || whole != tt.whole {
+			t.Errorf("TokenToBalance(%s, %d) = %s, %d, want %s, %d", tt.value, tt.decimals, balance, whole, tt.balance, tt.whole)
+		}
+	}
+
+	if balance, whole := TokenToBalance(nil, 18); !balance.IsZero() || 0 != whole {
+		t.Errorf("TokenToBalance(nil) = %s, %d", balance, whole)
+	}
+}
+
+func TestAddMyTotalAmount(t *testing.T) {
+	tests := []struct {
+		total, amount uint64
+		want          uint64
+	}{
+		{total: 0, amount: 0, want: 0},
+		{total: 1, amount: 2, want: 3},
+		{total: math.MaxInt64 - 1, amount: 1, want: math.MaxInt64},
+		{total: math.MaxInt64, amount: 1, want: math.MaxInt64},
+		{total: 1, amount: math.MaxInt64, want: math.MaxInt64},
+		{total: math.MaxInt64, amount: math.MaxInt64, want: math.MaxInt64},
+	}
+
+	for _, tt := range tests {
+		if got := addMyTotalAmount(tt.total, tt.amount); got != tt.want {
+			t.Errorf("addMyTotalAmount(%d, %d) = %d, want %d", tt.total, tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestWithdrawFee(t *testing.T) {
+	tiers := "0:0.05,5:0.03,10:0.01"
+	tests := []struct {
+		name    string
+		configs []*Config
+		vip     uint64
+		amount  string
+		want    string
+	}{
+		{name: "未配置不收", amount: "100", want: "0"},
+		{name: "未知模式不收", configs: testConfigs("withdraw_fee_mode", "other", "withdraw_fee_flat", "2"), amount: "100", want: "0"},
+		{name: "固定", configs: testConfigs("withdraw_fee_mode", "flat", "withdraw_fee_flat", "2"), amount: "100", want: "2"},
+		{name: "固定不看最低", configs: testConfigs("withdraw_fee_mode", "flat", "withdraw_fee_flat", "2", "withdraw_fee_min", "5"), amount: "100", want: "2"},
+		{name: "固定为负按0", configs: testConfigs("withdraw_fee_mode", "flat", "withdraw_fee_flat", "-2"), amount: "100", want: "0"},
+		{name: "比例", configs: testConfigs("withdraw_fee_mode", "percent", "withdraw_fee_percent", "0.01"), amount: "1000", want: "10"},
+		{name: "比例截断", configs: testConfigs("withdraw_fee_mode", "percent", "withdraw_fee_percent", "0.003"), amount: "0.000000000000000333", want: "0"},
+		{name: "比例低于最低", configs: testConfigs("withdraw_fee_mode", "percent", "withdraw_fee_percent", "0.01", "withdraw_fee_min", "5"), amount: "100", want: "5"},
+		{name: "比例高于最低", configs: testConfigs("withdraw_fee_mode", "percent", "withdraw_fee_percent", "0.01", "withdraw_fee_min", "5"), amount: "1000", want: "10"},
+		{name: "比例等于最低", configs: testConfigs("withdraw_fee_mode", "percent", "withdraw_fee_percent", "0.01", "withdraw_fee_min", "5"), amount: "500", want: "5"},
+		{name: "分档vip0", configs: testConfigs("withdraw_fee_mode", "tiered", "withdraw_fee_vip_tiers", tiers), vip: 0, amount: "100", want: "5"},
+		{name: "分档取不超过vip的最高档", configs: testConfigs("withdraw_fee_mode", "tiered", "withdraw_fee_vip_tiers", tiers), vip: 7, amount: "100", want: "3"},
+		{name: "分档边界", configs: testConfigs("withdraw_fee_mode", "tiered", "withdraw_fee_vip_tiers", tiers), vip: 10, amount: "100", want: "1"},
+		{name: "分档乱序", configs: testConfigs("withdraw_fee_mode", "tiered", "withdraw_fee_vip_tiers", "10:0.01, 0:0.05 ,5:0.03"), vip: 12, amount: "100", want: "1"},
+		{name: "分档跳过错误配置", configs: testConfigs("withdraw_fee_mode", "tiered", "withdraw_fee_vip_tiers", "0:0.05,x:0.01,3,5:abc"), vip: 12, amount: "100", want: "5"},
+		{name: "分档最低", configs: testConfigs("withdraw_fee_mode", "tiered", "withdraw_fee_vip_tiers", tiers, "withdraw_fee_min", "2"), vip: 12, amount: "100", want: "2"},
+		{name: "分档没有命中按比例", configs: testConfigs("withdraw_fee_mode", "tiered", "withdraw_fee_vip_tiers", "5:0.03", "withdraw_fee_percent", "0.02"), vip: 1, amount: "100", want: "2"},
+	}
+
+	for _, tt := range tests {
+		uuc := newTestUserUseCase(&fakeUserRepo{configs: tt.configs})
+		got := uuc.WithdrawFee(&User{Vip: tt.vip}, mustParse(t, tt.amount))
+		if got.String() != tt.want {
+			t.Errorf("%s: WithdrawFee(vip %d, %s) = %s, want %s", tt.name, tt.vip, tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestTransferFee(t *testing.T) {
+	tests := []struct {
+		percent, min string
+		amount       string
+		want         string
+	}{
+		{percent: "0", min: "0", amount: "100", want: "0"},
+		{percent: "0.01", min: "0", amount: "100", want: "1"},
+		{percent: "0.01", min: "0", amount: "0.000000000000000099", want: "0"},
+		{percent: "0.01", min: "2", amount: "100", want: "2"},
+		{percent: "0.01", min: "2", amount: "200", want: "2"},
+		{percent: "0.01", min: "2", amount: "300", want: "3"},
+		{percent: "0", min: "0.5", amount: "100", want: "0.5"},
+		{percent: "-0.01", min: "0", amount: "100", want: "0"},
+	}
+
+	for _, tt := range tests {
+		cfg := &TransferConfig{FeePercent: mustParse(t, tt.percent), FeeMin: mustParse(t, tt.min)}
+		if got := cfg.TransferFee(mustParse(t, tt.amount)).String(); got != tt.want {
+			t.Errorf("TransferFee(%s) percent %s min %s = %s, want %s", tt.amount, tt.percent, tt.min, got, tt.want)
+		}
+	}
+}
+
+func TestTransferDailyLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		dayAmount string
+		dayCount  int64
+		amount    string
+		wantErr   bool
+	}{
+		{name: "额度内", dayAmount: "0", amount: "100", wantErr: false},
+		{name: "恰好到额度", dayAmount: "900", amount: "100", wantErr: false},
+		{name: "差一点到额度", dayAmount: "899.999999999999999999", amount: "100", wantErr: false},
+		{name: "超出一点", dayAmount: "900.000000000000000001", amount: "100", wantErr: true},
+		{name: "单笔超额度", dayAmount: "0", amount: "1000.000000000000000001", wantErr: true},
+		{name: "笔数恰好到上限", dayAmount: "0", dayCount: 2, amount: "1", wantErr: false},
+		{name: "笔数超上限", dayAmount: "0", dayCount: 3, amount: "1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		repo := &fakeUserRepo{
+			configs: testConfigs("transfer_daily_limit", "1000", "transfer_daily_count", "3"),
+			users: []*User{
+				{ID: 1, Address: "0x1", Amount: mustParse(t, "10000")},
+				{ID: 2, Address: "0x2"},
+			},
+			dayAmount: mustParse(t, tt.dayAmount),
+			dayCount:  tt.dayCount,
+		}
+
+		_, err := newTestUserUseCase(repo).Transfer(context.Background(), 1, "0x2", mustParse(t, tt.amount))
+		if tt.wantErr {
+			if nil == err || 0 != repo.transfers {
+				t.Errorf("%s: Transfer(%s) after %s/%d = %v, want error", tt.name, tt.amount, tt.dayAmount, tt.dayCount, err)
+			}
+			continue
+		}
+		if nil != err || 1 != repo.transfers {
+			t.Errorf("%s: Transfer(%s) after %s/%d = %v", tt.name, tt.amount, tt.dayAmount, tt.dayCount, err)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	// 1 根用户，2 挂在1下面，3 已禁用，4 没有推荐关系，5 推荐链里有自己（脏数据）
+	newRepo := func() *fakeUserRepo {
+		return &fakeUserRepo{
+			users: []*User{
+				{ID: 1, Address: "0x0000000000000000000000000000000000000001"},
+				{ID: 2, Address: "0x0000000000000000000000000000000000000002"},
+				{ID: 3, Address: "0x0000000000000000000000000000000000000003", IsDelete: 1},
+				{ID: 4, Address: "0x0000000000000000000000000000000000000004"},
+				{ID: 5, Address: "0x0000000000000000000000000000000000000005"},
+			},
+			recommends: []*UserRecommend{
+				{UserId: 1, RecommendCode: ""},
+				{UserId: 2, RecommendCode: "D1"},
+				{UserId: 3, RecommendCode: "D1"},
+				{UserId: 5, RecommendCode: "D1D5"},
+			},
+		}
+	}
+
+	address := "0x00000000000000000000000000000000000000Aa"
+	tests := []struct {
+		name     string
+		address  string
+		inviter  string
+		wantCode string
+		wantErr  string
+	}{
+		{name: "按地址邀请", address: address, inviter: "0x0000000000000000000000000000000000000002", wantCode: "D1D2"},
+		{name: "按邀请码邀请", address: address, inviter: " 1 ", wantCode: "D1"},
+		{name: "重复注册", address: "0x0000000000000000000000000000000000000002", inviter: "1", wantErr: "地址已注册"},
+		{name: "邀请自己", address: address, inviter: address, wantErr: "不能邀请自己"},
+		{name: "邀请自己大小写不同", address: address, inviter: " 0x00000000000000000000000000000000000000aA ", wantErr: "不能邀请自己"},
+		{name: "没有邀请人", address: address, inviter: "", wantErr: "邀请人不存在"},
+		{name: "邀请地址不存在", address: address, inviter: "0x0000000000000000000000000000000000000099", wantErr: "邀请人不存在"},
+		{name: "邀请码不存在", address: address, inviter: "99", wantErr: "邀请人不存在"},
+		{name: "邀请码错误", address: address, inviter: "abc", wantErr: "邀请人不存在"},
+		{name: "邀请码为0", address: address, inviter: "0", wantErr: "邀请人不存在"},
+		{name: "邀请人已禁用", address: address, inviter: "3", wantErr: "邀请人已禁用"},
+		{name: "邀请人没有推荐关系", address: address, inviter: "4", wantErr: "邀请人推荐关系不存在"},
+		{name: "推荐链成环", address: address, inviter: "5", wantErr: "推荐关系成环"},
+	}
+
+	for _, tt := range tests {
+		repo := newRepo()
+		recommends := len(repo.recommends)
+
+		_, err := newTestUserUseCase(repo).Register(context.Background(), tt.address, tt.inviter, "test")
+		if "" != tt.wantErr {
+			if nil == err || tt.wantErr != errors.FromError(err).Message {
+				t.Errorf("%s: Register(%s, %q) = %v, want %s", tt.name, tt.address, tt.inviter, err, tt.wantErr)
+			}
+			if recommends != len(repo.recommends) {
+				t.Errorf("%s: recommend created on error", tt.name)
+			}
+			continue
+		}
+		if nil != err {
+			t.Errorf("%s: Register(%s, %q): %v", tt.name, tt.address, tt.inviter, err)
+			continue
+		}
+
+		user, _ := repo.GetUserByAddress(tt.address)
+		recommend, _ := repo.GetUserRecommendByUserId(user.ID)
+		if nil == recommend || tt.wantCode != recommend.RecommendCode {
+			t.Errorf("%s: recommend = %+v, want %s", tt.name, recommend, tt.wantCode)
+		}
+	}
+
+	// 新用户的id已出现在邀请人的推荐链里（脏数据）也算成环
+	repo := newRepo()
+	repo.recommends[1].RecommendCode = "D1D6"
+	_, err := newTestUserUseCase(repo).Register(context.Background(), address, "2", "test")
+	if nil == err || "推荐关系成环" != errors.FromError(err).Message {
+		t.Errorf("Register with own id in chain = %v, want cycle error", err)
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"cardbinance/internal/biz"
 	"cardbinance/internal/conf"
 	"context"
+	"errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
+	mysqlDriver "github.com/go-sql-driver/mysql"
 	"github.com/google/wire"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -61,7 +63,7 @@ func (d *Data) DB(ctx context.Context) *gorm.DB {
 func NewDB(c *conf.Data) *gorm.DB {
 	f, err := os.OpenFile("../../log/sql.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 	if err != nil {
-		log.Errorf("failed opening sql.log", err)
+		log.Errorf("failed opening sql.log: %v", err)
 		panic("failed opening sql.log")
 	}
 
@@ -124,3 +126,9 @@ func Paginate(page, pageSize int) func(db *gorm.DB) *gorm.DB {
 		return db.Offset(offset).Limit(pageSize)
 	}
 }
+
+// isDuplicateEntry 唯一索引冲突
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysqlDriver.MySQLError
+	return errors.As(err, &mysqlErr) && 1062 == mysqlErr.Number
+}
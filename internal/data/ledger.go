@@ -0,0 +1,234 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+// 系统账户，和用户账户对记，每笔分录借贷合计为0
+const (
+	ledgerAccountDeposit  = "system:deposit"  // 链上充值转入
+	ledgerAccountWithdraw = "system:withdraw" // 链上提现转出
+	ledgerAccountFee      = "system:fee"      // 提现手续费收入
+	ledgerAccountCard     = "system:card"     // 开卡费用及退款
+	ledgerAccountReward   = "system:reward"   // 平台发放的开卡收益
+	ledgerAccountOpening  = "system:opening"  // 上线前余额期初
+)
+
+type LedgerAccount struct {
+	ID        uint64      `gorm:"primarykey;type:int"`
+	UserId    uint64      `gorm:"type:int;not null"`
+	Code      string      `gorm:"type:varchar(100);not null;uniqueIndex"`
+	Balance   money.Money `gorm:"type:decimal(65,20);not null"`
+	CreatedAt time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time   `gorm:"type:datetime;not null"`
+}
+
+type LedgerEntry struct {
+	ID        uint64    `gorm:"primarykey;type:int"`
+	Reason    uint64    `gorm:"type:int;not null"`
+	Ref       string    `gorm:"type:varchar(100);not null"`
+	CreatedAt time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time `gorm:"type:datetime;not null"`
+}
+
+type LedgerPosting struct {
+	ID        uint64      `gorm:"primarykey;type:int"`
+	EntryId   uint64      `gorm:"type:int;not null;index"`
+	AccountId uint64      `gorm:"type:int;not null;index"`
+	Amount    money.Money `gorm:"type:decimal(65,20);not null"`
+	CreatedAt time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time   `gorm:"type:datetime;not null"`
+}
+
+// ledgerLine 分录中的一行，正数入账，负数出账
+type ledgerLine struct {
+	Code   string
+	UserId uint64
+	Amount money.Money
+}
+
+func ledgerUserAccount(userId uint64) string {
+	return fmt.Sprintf("user:%d", userId)
+}
+
+// userLine 用户余额账户
+func userLine(userId uint64, amount money.Money) ledgerLine {
+	return ledgerLine{Code: ledgerUserAccount(userId), UserId: userId, Amount: amount}
+}
+
+// systemLine 系统账户
+func systemLine(code string, amount money.Money) ledgerLine {
+	return ledgerLine{Code: code, Amount: amount}
+}
+
+// postLedger 记一笔平衡分录并更新账户余额，在事务内调用，和 user.amount 的修改一起提交
+func (u *UserRepo) postLedger(ctx context.Context, reason uint64, ref string, lines ...ledgerLine) error {
+	total := money.Zero()
+	for _, line := range lines {
+		total = total.Add(line.Amount)
+	}
+	if 2 > len(lines) || !total.IsZero() {
+		return errors.New(500, "LEDGER_UNBALANCED", "账务分录不平")
+	}
+
+	entry := LedgerEntry{Reason: reason, Ref: ref}
+	res := u.data.DB(ctx).Table("ledger_entry").Create(&entry)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_LEDGER_ERROR", "账务分录创建失败")
+	}
+
+	for _, line := range lines {
+		if line.Amount.IsZero() {
+			continue
+		}
+
+		account, err := u.getOrCreateLedgerAccount(ctx, line.Code, line.UserId)
+		if nil != err {
+			return err
+		}
+
+		resUpdate := u.data.DB(ctx).Table("ledger_account").Where("id=?", account.ID).
+			Updates(map[string]interface{}{
+				"balance":    gorm.Expr("balance + ?", line.Amount),
+				"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+			})
+		if resUpdate.Error != nil || 0 >= resUpdate.RowsAffected {
+			return errors.New(500, "UPDATE_LEDGER_ERROR", "账户余额修改失败")
+		}
+
+		posting := LedgerPosting{EntryId: entry.ID, AccountId: account.ID, Amount: line.Amount}
+		resInsert := u.data.DB(ctx).Table("ledger_posting").Create(&posting)
+		if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+			return errors.New(500, "CREATE_LEDGER_ERROR", "账务分录创建失败")
+		}
+	}
+
+	return nil
+}
+
+func (u *UserRepo) getOrCreateLedgerAccount(ctx context.Context, code string, userId uint64) (*LedgerAccount, error) {
+	var account LedgerAccount
+	err := u.data.DB(ctx).Table("ledger_account").Where("code=?", code).First(&account).Error
+	if nil == err {
+		return &account, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New(500, "LEDGER ERROR", err.Error())
+	}
+
+	account = LedgerAccount{UserId: userId, Code: code}
+	res := u.data.DB(ctx).Table("ledger_account").Create(&account)
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return nil, errors.New(500, "CREATE_LEDGER_ERROR", "账户创建失败")
+	}
+
+	return &account, nil
+}
+
+// GetLedgerMismatchPage user.amount 和账本余额不一致的用户
+func (u *UserRepo) GetLedgerMismatchPage(b *biz.Pagination) ([]*biz.LedgerMismatch, error, int64) {
+	var (
+		count int64
+		rows  []*struct {
+			UserId  uint64
+			Address string
+			Amount  money.Money
+			Balance money.Money
+		}
+	)
+	res := make([]*biz.LedgerMismatch, 0)
+
+	instance := u.data.db.Table("user").
+		Select("user.id as user_id, user.address as address, user.amount as amount, IFNULL(ledger_account.balance,0) as balance").
+		Joins("LEFT JOIN ledger_account ON ledger_account.code = CONCAT('user:', user.id)").
+		Where("IFNULL(user.amount,0) <> IFNULL(ledger_account.balance,0)")
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("user.id asc").Scan(&rows).Error; err != nil {
+		return nil, errors.New(500, "LEDGER ERROR", err.Error()), 0
+	}
+
+	for _, row := range rows {
+		res = append(res, &biz.LedgerMismatch{
+			UserId:  row.UserId,
+			Address: row.Address,
+			Amount:  row.Amount,
+			Balance: row.Balance,
+		})
+	}
+
+	return res, nil, count
+}
+
+// ledgerOpeningMissing 用户账户还没有期初分录，按账户查，老的 ref=opening 记录也算；没有账户的用户也算
+const ledgerOpeningMissing = "NOT EXISTS (SELECT 1 FROM ledger_entry JOIN ledger_posting ON ledger_posting.entry_id = ledger_entry.id " +
+	"WHERE ledger_entry.reason = ? AND ledger_posting.account_id = ledger_account.id)"
+
+// GetUsersWithoutOpening 还没记期初且余额和账本不一致的用户
+func (u *UserRepo) GetUsersWithoutOpening(limit int) ([]*biz.User, error) {
+	var users []*User
+	res := make([]*biz.User, 0)
+	if err := u.data.db.Table("user").Select("user.*").
+		Joins("LEFT JOIN ledger_account ON ledger_account.code = CONCAT('user:', user.id)").
+		Where(ledgerOpeningMissing, biz.LedgerReasonOpening).
+		Where("IFNULL(user.amount,0) <> IFNULL(ledger_account.balance,0)").
+		Order("user.id asc").Limit(limit).Find(&users).Error; err != nil {
+		return nil, errors.New(500, "LEDGER ERROR", err.Error())
+	}
+
+	for _, user := range users {
+		res = append(res, &biz.User{
+			ID:      user.ID,
+			Address: user.Address,
+			Amount:  user.Amount,
+		})
+	}
+
+	return res, nil
+}
+
+// OpenLedgerUserBalance 锁用户行后按 user.amount 和账本余额的差额记期初，在事务内调用；已记过期初或无差额返回false
+func (u *UserRepo) OpenLedgerUserBalance(ctx context.Context, userId uint64) (bool, error) {
+	amount, err := u.LockUserAmount(ctx, userId)
+	if nil != err {
+		return false, err
+	}
+
+	balance := money.Zero()
+	var account LedgerAccount
+	err = u.data.DB(ctx).Table("ledger_account").Where("code=?", ledgerUserAccount(userId)).First(&account).Error
+	if nil == err {
+		var opened int64
+		if err = u.data.DB(ctx).Table("ledger_entry").
+			Joins("JOIN ledger_posting ON ledger_posting.entry_id = ledger_entry.id").
+			Where("ledger_entry.reason=?", biz.LedgerReasonOpening).Where("ledger_posting.account_id=?", account.ID).
+			Count(&opened).Error; err != nil {
+			return false, errors.New(500, "LEDGER ERROR", err.Error())
+		}
+		if 0 < opened {
+			return false, nil
+		}
+
+		balance = account.Balance
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, errors.New(500, "LEDGER ERROR", err.Error())
+	}
+
+	diff := amount.Sub(balance)
+	if diff.IsZero() {
+		return false, nil
+	}
+
+	err = u.postLedger(ctx, biz.LedgerReasonOpening, fmt.Sprintf("opening:%d", userId), userLine(userId, diff), systemLine(ledgerAccountOpening, diff.Neg()))
+	if nil != err {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,261 @@
+package data
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+	"sort"
+	"time"
+)
+
+type ReconcileDiscrepancy struct {
+	ID        uint64      `gorm:"primarykey;type:int"`
+	RunId     int64       `gorm:"type:bigint;not null;index"`
+	UserId    uint64      `gorm:"type:int;not null"`
+	Address   string      `gorm:"type:varchar(100);not null"`
+	Amount    money.Money `gorm:"type:decimal(65,20);not null"`
+	Expected  money.Money `gorm:"type:decimal(65,20);not null"`
+	Diff      money.Money `gorm:"type:decimal(65,20);not null"`
+	Detail    string      `gorm:"type:text"` // 各来源合计，json
+	CreatedAt time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time   `gorm:"type:datetime;not null"`
+}
+
+type reconcileReasonDetail struct {
+	Source string `json:"source"`
+	Reason uint64 `json:"reason"`
+	Amount string `json:"amount"`
+}
+
+// GetRewardTotalsByUser reward 按用户和类型合计
+func (u *UserRepo) GetRewardTotalsByUser() (map[uint64]map[uint64]money.Money, error) {
+	var totals []*struct {
+		UserId uint64
+		Reason uint64
+		Total  money.Money
+	}
+	if err := u.data.db.Table("reward").Select("user_id, reason, sum(amount) as total").
+		Group("user_id, reason").Scan(&totals).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	res := make(map[uint64]map[uint64]money.Money, 0)
+	for _, v := range totals {
+		if _, ok := res[v.UserId]; !ok {
+			res[v.UserId] = make(map[uint64]money.Money, 0)
+		}
+		res[v.UserId][v.Reason] = v.Total
+	}
+
+	return res, nil
+}
+
+// GetTransferInTotalsByUser 旧的划转只给转出方记 reward（one为0），收款方按 reward.address 对应用户合计
+func (u *UserRepo) GetTransferInTotalsByUser() (map[uint64]money.Money, error) {
+	var totals []*struct {
+		UserId uint64
+		Total  money.Money
+	}
+	if err := u.data.db.Table("reward").Select("user.id as user_id, sum(reward.amount) as total").
+		Joins("JOIN user ON user.address = reward.address").
+		Where("reward.reason=?", biz.RewardReasonTransfer).Where("reward.one=?", 0).Group("user.id").Scan(&totals).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	res := make(map[uint64]money.Money, 0)
+	for _, v := range totals {
+		res[v.UserId] = v.Total
+	}
+
+	return res, nil
+}
+
+// GetDepositTotalsByUser 充值记录按用户合计，rel_amount 上线前的记录按 amount_two 入账
+func (u *UserRepo) GetDepositTotalsByUser() (map[uint64]money.Money, error) {
+	var totals []*struct {
+		UserId uint64
+		Total  money.Money
+	}
+	if err := u.data.db.Table("eth_user_record").Select("user_id, sum(IF(rel_amount=0, amount_two, rel_amount)) as total").
+		Group("user_id").Scan(&totals).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	res := make(map[uint64]money.Money, 0)
+	for _, v := range totals {
+		res[v.UserId] = v.Total
+	}
+
+	return res, nil
+}
+
+// CreateReconcileDiscrepancies 写入一次对账的差异
+func (u *UserRepo) CreateReconcileDiscrepancies(ctx context.Context, runId int64, list []*biz.ReconcileDiscrepancy) error {
+	for _, v := range list {
+		details := make([]*reconcileReasonDetail, 0)
+		for _, r := range v.Reasons {
+			details = append(details, &reconcileReasonDetail{
+				Source: r.Source,
+				Reason: r.Reason,
+				Amount: r.Amount.String(),
+			})
+		}
+
+		detail, err := json.Marshal(details)
+		if nil != err {
+			return errors.New(500, "CREATE_RECONCILE_ERROR", "对账差异创建失败")
+		}
+
+		discrepancy := ReconcileDiscrepancy{
+			RunId:    runId,
+			UserId:   v.UserId,
+			Address:  v.Address,
+			Amount:   v.Amount,
+			Expected: v.Expected,
+			Diff:     v.Diff,
+			Detail:   string(detail),
+		}
+		res := u.data.DB(ctx).Table("reconcile_discrepancy").Create(&discrepancy)
+		if res.Error != nil || 0 >= res.RowsAffected {
+			return errors.New(500, "CREATE_RECONCILE_ERROR", "对账差异创建失败")
+		}
+	}
+
+	return nil
+}
+
+// GetReconcileLastRunId 最近一次有差异的对账
+func (u *UserRepo) GetReconcileLastRunId() (int64, error) {
+	var runId int64
+	if err := u.data.db.Table("reconcile_discrepancy").Select("IFNULL(max(run_id),0)").Scan(&runId).Error; err != nil {
+		return 0, errors.New(500, "RECONCILE ERROR", err.Error())
+	}
+
+	return runId, nil
+}
+
+func (u *UserRepo) GetReconcileDiscrepancyPage(b *biz.Pagination, runId int64) ([]*biz.ReconcileDiscrepancy, error, int64) {
+	var (
+		discrepancies []*ReconcileDiscrepancy
+		count         int64
+	)
+	res := make([]*biz.ReconcileDiscrepancy, 0)
+
+	instance := u.data.db.Table("reconcile_discrepancy").Where("run_id=?", runId)
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("user_id asc").Find(&discrepancies).Error; err != nil {
+		return nil, errors.New(500, "RECONCILE ERROR", err.Error()), 0
+	}
+
+	for _, v := range discrepancies {
+		var details []*reconcileReasonDetail
+		if "" != v.Detail {
+			if err := json.Unmarshal([]byte(v.Detail), &details); nil != err {
+				return nil, errors.New(500, "RECONCILE ERROR", err.Error()), 0
+			}
+		}
+
+		reasons := make([]*biz.ReconcileReason, 0)
+		for _, d := range details {
+			amount, _ := money.Parse(d.Amount)
+			reasons = append(reasons, &biz.ReconcileReason{
+				Source: d.Source,
+				Reason: d.Reason,
+				Amount: amount,
+			})
+		}
+
+		res = append(res, &biz.ReconcileDiscrepancy{
+			ID:        v.ID,
+			RunId:     v.RunId,
+			UserId:    v.UserId,
+			Address:   v.Address,
+			Amount:    v.Amount,
+			Expected:  v.Expected,
+			Diff:      v.Diff,
+			Reasons:   reasons,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	return res, nil, count
+}
+
+// GetReconcileEntries 用户的充值记录、reward 流水和转入流水，按时间排序
+func (u *UserRepo) GetReconcileEntries(userId uint64, address string) ([]*biz.ReconcileEntry, error) {
+	var (
+		records   []*EthUserRecord
+		rewards   []*Reward
+		transfers []*Reward
+	)
+	res := make([]*biz.ReconcileEntry, 0)
+
+	if err := u.data.db.Table("eth_user_record").Where("user_id=?", userId).Order("id asc").Find(&records).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	if err := u.data.db.Table("reward").Where("user_id=?", userId).Order("id asc").Find(&rewards).Error; err != nil {
+		return nil, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	if "" != address {
+		if err := u.data.db.Table("reward").Where("reason=?", biz.RewardReasonTransfer).Where("one=?", 0).Where("address=?", address).Order("id asc").Find(&transfers).Error; err != nil {
+			return nil, errors.New(500, "REWARD ERROR", err.Error())
+		}
+	}
+
+	for _, v := range records {
+		amount := v.RelAmount
+		if amount.IsZero() {
+			amount = money.FromUint(v.AmountTwo)
+		}
+
+		res = append(res, &biz.ReconcileEntry{
+			Source:    biz.ReconcileSourceDeposit,
+			ID:        uint64(v.ID),
+			Reason:    biz.RewardReasonDeposit,
+			Amount:    amount,
+			Address:   v.Hash,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	for _, v := range rewards {
+		amount := money.Zero()
+		if sign, ok := biz.ReconcileRewardSign(v.Reason); ok {
+			amount = v.Amount
+			if 0 > sign {
+				amount = amount.Neg()
+			}
+		}
+
+		res = append(res, &biz.ReconcileEntry{
+			Source:    biz.ReconcileSourceReward,
+			ID:        v.ID,
+			Reason:    v.Reason,
+			Amount:    amount,
+			Address:   v.Address,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	for _, v := range transfers {
+		res = append(res, &biz.ReconcileEntry{
+			Source:    biz.ReconcileSourceTransferIn,
+			ID:        v.ID,
+			Reason:    v.Reason,
+			Amount:    v.Amount,
+			Address:   v.Address,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].CreatedAt.Before(res[j].CreatedAt)
+	})
+
+	return res, nil
+}
@@ -2,52 +2,58 @@ package data
 
 import (
 	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/money"
 	"context"
+	"crypto/rand"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"math"
+	"math/big"
 	"strconv"
 	"time"
 )
 
 type User struct {
-	ID              uint64    `gorm:"primarykey;type:int"`
-	Address         string    `gorm:"type:varchar(100);default:'no'"`
-	Card            string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardOrderId     string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardNumber      string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardNumberTwo   string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardAmount      float64   `gorm:"type:decimal(65,20);not null"`
-	Amount          float64   `gorm:"type:decimal(65,20)"`
-	IsDelete        uint64    `gorm:"type:int"`
-	Vip             uint64    `gorm:"type:int"`
-	MyTotalAmount   uint64    `gorm:"type:bigint"`
-	AmountTwo       uint64    `gorm:"type:bigint"`
-	CardIdTwo       string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CardUserId      string    `gorm:"type:varchar(45);not null;default:'0'"`
-	FirstName       string    `gorm:"type:varchar(45);not null;default:'no'"`
-	LastName        string    `gorm:"type:varchar(45);not null;default:'no'"`
-	BirthDate       string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Email           string    `gorm:"type:varchar(100);not null;default:'no'"`
-	CountryCode     string    `gorm:"type:varchar(45);not null;default:'no'"`
-	Phone           string    `gorm:"type:varchar(45);not null;default:'no'"`
-	City            string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Country         string    `gorm:"type:varchar(100);not null;default:'no'"`
-	Street          string    `gorm:"type:varchar(100);not null;default:'no'"`
-	PostalCode      string    `gorm:"type:varchar(45);not null;default:'no'"`
-	MaxCardQuota    uint64    `gorm:"type:bigint"`
-	MaxCardQuotaTwo uint64    `gorm:"type:bigint"`
-	ProductId       string    `gorm:"type:varchar(45);not null;default:'0'"`
-	ProductIdTwo    string    `gorm:"type:varchar(45);not null;default:'0'"`
-	CreatedAt       time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt       time.Time `gorm:"type:datetime;not null"`
-	VipTwo          uint64    `gorm:"type:int"`
-	VipThree        uint64    `gorm:"type:int"`
-	CardTwo         uint64    `gorm:"type:int"`
-	CanVip          uint64    `gorm:"type:int"`
-	UserCount       uint64    `gorm:"type:int"`
-	CardType        uint64    `gorm:"type:int"`
+	ID              uint64      `gorm:"primarykey;type:int"`
+	Address         string      `gorm:"type:varchar(100);default:'no'"`
+	Card            string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardOrderId     string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardNumber      string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardNumberTwo   string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardAmount      money.Money `gorm:"type:decimal(65,20);not null"`
+	Amount          money.Money `gorm:"type:decimal(65,20)"`
+	IsDelete        uint64      `gorm:"type:int"`
+	Vip             uint64      `gorm:"type:int"`
+	MyTotalAmount   uint64      `gorm:"type:bigint"`
+	AmountTwo       uint64      `gorm:"type:bigint"`
+	CardIdTwo       string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CardUserId      string      `gorm:"type:varchar(45);not null;default:'0'"`
+	FirstName       string      `gorm:"type:varchar(45);not null;default:'no'"`
+	LastName        string      `gorm:"type:varchar(45);not null;default:'no'"`
+	BirthDate       string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Email           string      `gorm:"type:varchar(100);not null;default:'no'"`
+	CountryCode     string      `gorm:"type:varchar(45);not null;default:'no'"`
+	Phone           string      `gorm:"type:varchar(45);not null;default:'no'"`
+	City            string      `gorm:"type:varchar(100);not null;default:'no'"`
+	Country         string      `gorm:"type:varchar(100);not null;default:'no'"`
+	Street          string      `gorm:"type:varchar(100);not null;default:'no'"`
+	PostalCode      string      `gorm:"type:varchar(45);not null;default:'no'"`
+	MaxCardQuota    uint64      `gorm:"type:bigint"`
+	MaxCardQuotaTwo uint64      `gorm:"type:bigint"`
+	ProductId       string      `gorm:"type:varchar(45);not null;default:'0'"`
+	ProductIdTwo    string      `gorm:"type:varchar(45);not null;default:'0'"`
+	CreatedAt       time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt       time.Time   `gorm:"type:datetime;not null"`
+	VipTwo          uint64      `gorm:"type:int"`
+	VipThree        uint64      `gorm:"type:int"`
+	CardTwo         uint64      `gorm:"type:int"`
+	CanVip          uint64      `gorm:"type:int"`
+	UserCount       uint64      `gorm:"type:int"`
+	CardType        uint64      `gorm:"type:int"`
 }
 
 type Admin struct {
@@ -75,14 +81,14 @@ type Config struct {
 }
 
 type Reward struct {
-	ID        uint64    `gorm:"primarykey;type:int"`
-	UserId    uint64    `gorm:"type:int;not null"`
-	Amount    float64   `gorm:"type:decimal(65,20);not null"`
-	Reason    uint64    `gorm:"type:int;not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt time.Time `gorm:"type:datetime;not null"`
-	Address   string    `gorm:"type:varchar(100);not null"`
-	One       uint64    `gorm:"type:int;not null"`
+	ID        uint64      `gorm:"primarykey;type:int"`
+	UserId    uint64      `gorm:"type:int;not null"`
+	Amount    money.Money `gorm:"type:decimal(65,20);not null"`
+	Reason    uint64      `gorm:"type:int;not null"`
+	CreatedAt time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt time.Time   `gorm:"type:datetime;not null"`
+	Address   string      `gorm:"type:varchar(100);not null"`
+	One       uint64      `gorm:"type:int;not null"`
 }
 
 type CardRecord struct {
@@ -97,25 +103,74 @@ type CardRecord struct {
 }
 
 type Withdraw struct {
+	ID          uint64      `gorm:"primarykey;type:int"`
+	UserId      uint64      `gorm:"type:int"`
+	Amount      money.Money `gorm:"type:decimal(65,20);not null"`
+	RelAmount   money.Money `gorm:"type:decimal(65,20);not null"`
+	Status      string      `gorm:"type:varchar(45);not null"`
+	Address     string      `gorm:"type:varchar(45);not null"`
+	Chain       string      `gorm:"type:varchar(45);not null"`
+	TxHash      string      `gorm:"type:varchar(100);not null"`
+	Nonce       uint64      `gorm:"type:bigint;not null"`
+	BlockNumber uint64      `gorm:"type:bigint;not null"`
+	RawTx       string      `gorm:"type:text;not null"`
+	Remark      string      `gorm:"type:varchar(500);not null"`
+	SentAt      *time.Time  `gorm:"type:datetime"`
+	CreatedAt   time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time   `gorm:"type:datetime;not null"`
+}
+
+type CardOrder struct {
 	ID        uint64    `gorm:"primarykey;type:int"`
-	UserId    uint64    `gorm:"type:int"`
-	Amount    float64   `gorm:"type:decimal(65,20);not null"`
-	RelAmount float64   `gorm:"type:decimal(65,20);not null"`
+	UserId    uint64    `gorm:"type:int;not null"`
+	OrderType uint64    `gorm:"type:int;not null"`
+	OrderNo   string    `gorm:"type:varchar(100);not null"`
+	Attempts  uint64    `gorm:"type:int;not null"`
 	Status    string    `gorm:"type:varchar(45);not null"`
-	Address   string    `gorm:"type:varchar(45);not null"`
+	Remark    string    `gorm:"type:varchar(500);not null"`
+	Deadline  time.Time `gorm:"type:datetime;not null"`
 	CreatedAt time.Time `gorm:"type:datetime;not null"`
 	UpdatedAt time.Time `gorm:"type:datetime;not null"`
 }
 
 type EthUserRecord struct {
-	ID        int64     `gorm:"primarykey;type:int"`
-	Hash      string    `gorm:"type:varchar(100);not null"`
-	UserId    int64     `gorm:"type:int;not null"`
-	Amount    string    `gorm:"type:varchar(45);not null"`
-	AmountTwo uint64    `gorm:"type:int;not null"`
-	CreatedAt time.Time `gorm:"type:datetime;not null"`
-	UpdatedAt time.Time `gorm:"type:datetime;not null"`
-	Last      int64     `gorm:"type:int;not null"`
+	ID         int64       `gorm:"primarykey;type:int"`
+	Hash       string      `gorm:"type:varchar(100);not null"`
+	UserId     int64       `gorm:"type:int;not null"`
+	Amount     string      `gorm:"type:varchar(45);not null"`
+	AmountTwo  uint64      `gorm:"type:bigint;not null"`
+	RelAmount  money.Money `gorm:"type:decimal(65,20);not null"`
+	CreatedAt  time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time   `gorm:"type:datetime;not null"`
+	Last       int64       `gorm:"type:int;not null"`
+	Chain      string      `gorm:"type:varchar(45);not null"`
+	DepositKey string      `gorm:"type:varchar(200);not null;uniqueIndex"`
+}
+
+type DepositPending struct {
+	ID         uint64      `gorm:"primarykey;type:int"`
+	UserId     uint64      `gorm:"type:int;not null"`
+	Address    string      `gorm:"type:varchar(100);not null"`
+	Chain      string      `gorm:"type:varchar(45);not null"`
+	Hash       string      `gorm:"type:varchar(100);not null"`
+	DepositKey string      `gorm:"type:varchar(200);not null;uniqueIndex"`
+	Amount     string      `gorm:"type:varchar(100);not null"`
+	AmountTwo  uint64      `gorm:"type:bigint;not null"`
+	RelAmount  money.Money `gorm:"type:decimal(65,20);not null"`
+	Last       int64       `gorm:"type:int;not null"`
+	Status     string      `gorm:"type:varchar(45);not null"`
+	Remark     string      `gorm:"type:varchar(500);not null"`
+	CreatedAt  time.Time   `gorm:"type:datetime;not null"`
+	UpdatedAt  time.Time   `gorm:"type:datetime;not null"`
+}
+
+type DepositCheckpoint struct {
+	ID          uint64    `gorm:"primarykey;type:int"`
+	Name        string    `gorm:"type:varchar(45);not null"`
+	BlockNumber uint64    `gorm:"type:bigint;not null"`
+	BlockHash   string    `gorm:"type:varchar(100);not null"`
+	CreatedAt   time.Time `gorm:"type:datetime;not null"`
+	UpdatedAt   time.Time `gorm:"type:datetime;not null"`
 }
 
 type UserRepo struct {
@@ -133,17 +188,28 @@ func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
 func (u *UserRepo) SetNonceByAddress(ctx context.Context, wallet string) (int64, error) {
 	key := "wallet:" + wallet
 
-	val, err := u.data.rdb.Get(ctx, key).Result()
-	if err == redis.Nil {
-		// 设置键值，60 秒后自动过期
-		timestamp := time.Now().Unix()
-		return timestamp, u.data.rdb.Set(ctx, key, timestamp, 60*time.Second).Err()
+	// 随机nonce，不可预测
+	n, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		return -1, err
+	}
+	nonce := n.Int64()
 
-	} else if err != nil {
+	// 60 秒后自动过期；已有未过期的沿用，并发时只生成一个
+	ok, err := u.data.rdb.SetNX(ctx, key, nonce, 60*time.Second).Result()
+	if err != nil {
+		return -1, err
+	}
+
+	if ok {
+		return nonce, nil
+	}
+
+	val, err := u.data.rdb.Get(ctx, key).Result()
+	if err != nil {
 		return -1, err
 	}
 
-	// 转换为 int64 时间戳
 	t, errThree := strconv.ParseInt(val, 10, 64)
 	if errThree != nil {
 		return 0, errThree
@@ -152,23 +218,34 @@ func (u *UserRepo) SetNonceByAddress(ctx context.Context, wallet string) (int64,
 	return t, nil
 }
 
-// GetAndDeleteWalletTimestamp 获取并删除，确保只用一次（无并发可用）
-func (u *UserRepo) GetAndDeleteWalletTimestamp(ctx context.Context, wallet string) (string, error) {
-	key := "wallet:" + wallet
-
-	val, err := u.data.rdb.Get(ctx, key).Result()
+// GetWalletNonce 只读取不删除，验签通过后再用 DeleteWalletNonce 作废
+func (u *UserRepo) GetWalletNonce(ctx context.Context, wallet string) (string, error) {
+	val, err := u.data.rdb.Get(ctx, "wallet:"+wallet).Result()
 	if err == redis.Nil {
 		return "", nil
 	} else if err != nil {
 		return "", err
 	}
 
-	// 删除
-	if errTwo := u.data.rdb.Del(ctx, key).Err(); errTwo != nil {
-		return "", errTwo
+	return val, nil
+}
+
+// deleteWalletNonceScript 值未变才删除，避免删掉重新获取的nonce
+var deleteWalletNonceScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// DeleteWalletNonce 作废nonce，确保只用一次；并发时只有删除成功的一方返回true
+func (u *UserRepo) DeleteWalletNonce(ctx context.Context, wallet string, nonce string) (bool, error) {
+	deleted, err := deleteWalletNonceScript.Run(ctx, u.data.rdb, []string{"wallet:" + wallet}, nonce).Int64()
+	if err != nil {
+		return false, err
 	}
 
-	return val, nil
+	return 0 < deleted, nil
 }
 
 func (u *UserRepo) GetUserByAddress(address string) (*biz.User, error) {
@@ -274,6 +351,10 @@ func (u *UserRepo) GetUserById(userId uint64) (*biz.User, error) {
 		Amount:        user.Amount,
 		CardNumber:    user.CardNumber,
 		CardOrderId:   user.CardOrderId,
+		CardUserId:    user.CardUserId,
+		CardTwo:       user.CardTwo,
+		CardIdTwo:     user.CardIdTwo,
+		CardType:      user.CardType,
 		CreatedAt:     user.CreatedAt,
 		UpdatedAt:     user.UpdatedAt,
 	}, nil
@@ -425,6 +506,7 @@ func (u *UserRepo) UpdateWithdraw(ctx context.Context, id uint64, status string)
 		RelAmount: withdraw.RelAmount,
 		Status:    withdraw.Status,
 		Address:   withdraw.Address,
+		Chain:     withdraw.Chain,
 		CreatedAt: withdraw.CreatedAt,
 		UpdatedAt: withdraw.UpdatedAt,
 	}, nil
@@ -516,12 +598,21 @@ func (u *UserRepo) CreateCard(ctx context.Context, userId uint64, user *biz.User
 
 	reward.UserId = userId
 	reward.Amount = user.Amount
-	reward.Reason = 3 // 给我分红的理由
+	reward.Reason = biz.RewardReasonOpenCard
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
+	// 记账
+	err := u.postLedger(ctx, reward.Reason, fmt.Sprintf("reward:%d", reward.ID),
+		userLine(userId, user.Amount.Neg()),
+		systemLine(ledgerAccountCard, user.Amount),
+	)
+	if nil != err {
+		return err
+	}
+
 	return nil
 }
 
@@ -570,7 +661,7 @@ func (u *UserRepo) UpdateCardTwoNew(ctx context.Context, userId uint64, card str
 }
 
 // UpdateCardNo .
-func (u *UserRepo) UpdateCardNo(ctx context.Context, userId uint64, amount float64) error {
+func (u *UserRepo) UpdateCardNo(ctx context.Context, userId uint64, amount money.Money) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
 		Updates(map[string]interface{}{
 			"card_order_id": "no",
@@ -587,18 +678,27 @@ func (u *UserRepo) UpdateCardNo(ctx context.Context, userId uint64, amount float
 	)
 
 	reward.UserId = userId
-	reward.Amount = 10
-	reward.Reason = 177 // 给我分红的理由
+	reward.Amount = amount
+	reward.Reason = biz.RewardReasonOpenCardRefund
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
+	// 记账
+	err := u.postLedger(ctx, reward.Reason, fmt.Sprintf("reward:%d", reward.ID),
+		userLine(userId, amount),
+		systemLine(ledgerAccountCard, amount.Neg()),
+	)
+	if nil != err {
+		return err
+	}
+
 	return nil
 }
 
 // UpdateCardNoTwo .
-func (u *UserRepo) UpdateCardNoTwo(ctx context.Context, userId uint64, amount float64) error {
+func (u *UserRepo) UpdateCardNoTwo(ctx context.Context, userId uint64, amount money.Money) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
 		Updates(map[string]interface{}{
 			"card_id_two":     "no",
@@ -617,13 +717,22 @@ func (u *UserRepo) UpdateCardNoTwo(ctx context.Context, userId uint64, amount fl
 	)
 
 	reward.UserId = userId
-	reward.Amount = 10
-	reward.Reason = 17 // 给我分红的理由
+	reward.Amount = amount
+	reward.Reason = biz.RewardReasonCardTwoRefund
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
+	// 记账
+	err := u.postLedger(ctx, reward.Reason, fmt.Sprintf("reward:%d", reward.ID),
+		userLine(userId, amount),
+		systemLine(ledgerAccountCard, amount.Neg()),
+	)
+	if nil != err {
+		return err
+	}
+
 	return nil
 }
 
@@ -908,10 +1017,10 @@ func (u *UserRepo) GetUsersOpenCardStatusDoingTwo() ([]*biz.User, error) {
 	return res, nil
 }
 
-// GetWithdrawPassOrRewardedFirst .
+// GetWithdrawPassOrRewardedFirst 最早一笔待发放，rewarded老数据已迁回待审核（sql/009），不再发放
 func (u *UserRepo) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*biz.Withdraw, error) {
 	var withdraw *Withdraw
-	if err := u.data.db.Table("withdraw").Where("status=?", "rewarded").First(&withdraw).Error; err != nil {
+	if err := u.data.db.Table("withdraw").Where("status=?", biz.WithdrawStatusApproved).Order("id asc").First(&withdraw).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.NotFound("WITHDRAW_NOT_FOUND", "withdraw not found")
 		}
@@ -919,20 +1028,349 @@ func (u *UserRepo) GetWithdrawPassOrRewardedFirst(ctx context.Context) (*biz.Wit
 		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
 	}
 
-	return &biz.Withdraw{
-		ID:        withdraw.ID,
-		UserId:    withdraw.UserId,
-		Amount:    withdraw.Amount,
-		RelAmount: withdraw.RelAmount,
-		Status:    withdraw.Status,
-		Address:   withdraw.Address,
-		CreatedAt: withdraw.CreatedAt,
-		UpdatedAt: withdraw.UpdatedAt,
+	return toBizWithdraw(withdraw), nil
+}
+
+// GetWithdrawsPassOrRewarded 待发放，只取审核通过的，rewarded老数据已迁回待审核（sql/009）
+func (u *UserRepo) GetWithdrawsPassOrRewarded(limit int) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	if err := u.data.db.Table("withdraw").Where("status=?", biz.WithdrawStatusApproved).
+		Order("id asc").Limit(limit).Find(&withdraws).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, toBizWithdraw(withdraw))
+	}
+
+	return res, nil
+}
+
+// GetWithdrawsByStatus .
+func (u *UserRepo) GetWithdrawsByStatus(status string, limit int) ([]*biz.Withdraw, error) {
+	var withdraws []*Withdraw
+	res := make([]*biz.Withdraw, 0)
+	if err := u.data.db.Table("withdraw").Where("status=?", status).Order("id asc").Limit(limit).Find(&withdraws).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, toBizWithdraw(withdraw))
+	}
+
+	return res, nil
+}
+
+// GetWithdrawById .
+func (u *UserRepo) GetWithdrawById(id uint64) (*biz.Withdraw, error) {
+	var withdraw Withdraw
+	if err := u.data.db.Table("withdraw").Where("id=?", id).First(&withdraw).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return toBizWithdraw(&withdraw), nil
+}
+
+// GetWithdrawPage .
+func (u *UserRepo) GetWithdrawPage(b *biz.Pagination, status string, address string) ([]*biz.Withdraw, error, int64) {
+	var (
+		withdraws []*Withdraw
+		count     int64
+	)
+	res := make([]*biz.Withdraw, 0)
+
+	instance := u.data.db.Table("withdraw").Where("status=?", status)
+	if "" != address {
+		instance = instance.Where("address=?", address)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&withdraws).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error()), 0
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, toBizWithdraw(withdraw))
+	}
+
+	return res, nil, count
+}
+
+// GetWithdrawPageByUserId 用户提现记录，全部状态
+func (u *UserRepo) GetWithdrawPageByUserId(b *biz.Pagination, userId uint64) ([]*biz.Withdraw, error, int64) {
+	var (
+		withdraws []*Withdraw
+		count     int64
+	)
+	res := make([]*biz.Withdraw, 0)
+
+	instance := u.data.db.Table("withdraw").Where("user_id=?", userId)
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&withdraws).Error; err != nil {
+		return nil, errors.New(500, "WITHDRAW ERROR", err.Error()), 0
+	}
+
+	for _, withdraw := range withdraws {
+		res = append(res, toBizWithdraw(withdraw))
+	}
+
+	return res, nil, count
+}
+
+// LockUser 事务内锁定用户行，读到的是最新状态，同一用户的余额、开卡状态修改串行
+func (u *UserRepo) LockUser(ctx context.Context, userId uint64) (*biz.User, error) {
+	var user User
+	if err := u.data.DB(ctx).Table("user").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id=?", userId).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New(500, "USER_ERROR", "用户不存在")
+		}
+
+		return nil, errors.New(500, "USER ERROR", err.Error())
+	}
+
+	return &biz.User{
+		CardAmount:    user.CardAmount,
+		MyTotalAmount: user.MyTotalAmount,
+		AmountTwo:     user.AmountTwo,
+		IsDelete:      user.IsDelete,
+		Vip:           user.Vip,
+		ID:            user.ID,
+		Address:       user.Address,
+		Card:          user.Card,
+		Amount:        user.Amount,
+		CardNumber:    user.CardNumber,
+		CardOrderId:   user.CardOrderId,
+		CardUserId:    user.CardUserId,
+		CardTwo:       user.CardTwo,
+		CardIdTwo:     user.CardIdTwo,
+		CardType:      user.CardType,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
 	}, nil
 }
 
+// LockUserAmount 事务内锁定用户行，同一用户的提现、划转串行校验额度
+func (u *UserRepo) LockUserAmount(ctx context.Context, userId uint64) (money.Money, error) {
+	user, err := u.LockUser(ctx, userId)
+	if nil != err {
+		return money.Zero(), err
+	}
+
+	return user.Amount, nil
+}
+
+// GetUserWithdrawTotal 时间段内提现金额和笔数，不含驳回
+func (u *UserRepo) GetUserWithdrawTotal(ctx context.Context, userId uint64, since time.Time) (money.Money, int64, error) {
+	var total struct {
+		Amount money.Money
+		Count  int64
+	}
+	if err := u.data.DB(ctx).Table("withdraw").Select("IFNULL(sum(amount),0) as amount, count(*) as count").
+		Where("user_id=?", userId).Where("status<>?", biz.WithdrawStatusRejected).
+		Where("created_at>=?", since).Scan(&total).Error; err != nil {
+		return money.Zero(), 0, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return total.Amount, total.Count, nil
+}
+
+// GetUserWithdrawAddressFirstUse 地址首次提现时间，以及是否用过其他地址（地址变更）
+func (u *UserRepo) GetUserWithdrawAddressFirstUse(ctx context.Context, userId uint64, address string) (*time.Time, bool, error) {
+	var (
+		first Withdraw
+		other int64
+	)
+	if err := u.data.DB(ctx).Table("withdraw").Where("user_id=?", userId).Where("address<>?", address).
+		Where("status<>?", biz.WithdrawStatusRejected).Count(&other).Error; err != nil {
+		return nil, false, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	if err := u.data.DB(ctx).Table("withdraw").Where("user_id=?", userId).Where("address=?", address).
+		Where("status<>?", biz.WithdrawStatusRejected).Order("id asc").First(&first).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0 < other, nil
+		}
+
+		return nil, false, errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return &first.CreatedAt, 0 < other, nil
+}
+
+// GetWithdrawOutflow 时间段内热钱包已发出的金额，链上失败的不算
+func (u *UserRepo) GetWithdrawOutflow(ctx context.Context, since time.Time) (money.Money, error) {
+	var total struct {
+		Amount money.Money
+	}
+	if err := u.data.DB(ctx).Table("withdraw").Select("IFNULL(sum(rel_amount),0) as amount").
+		Where("status IN (?)", []string{biz.WithdrawStatusDoing, biz.WithdrawStatusSending, biz.WithdrawStatusConfirmed}).
+		Where("sent_at>=?", since).Scan(&total).Error; err != nil {
+		return money.Zero(), errors.New(500, "WITHDRAW ERROR", err.Error())
+	}
+
+	return total.Amount, nil
+}
+
+// UpdateWithdrawStatus 按原状态修改，防止重复处理
+func (u *UserRepo) UpdateWithdrawStatus(ctx context.Context, id uint64, fromStatus, status string, remark string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status=?", fromStatus).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"remark":     remark,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// RefundWithdraw 驳回退回余额
+func (u *UserRepo) RefundWithdraw(ctx context.Context, userId uint64, amount, amountRel money.Money, address string) error {
+	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
+		Updates(map[string]interface{}{
+			"amount":     gorm.Expr("amount + ?", amount),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	var (
+		reward Reward
+	)
+
+	reward.UserId = userId
+	reward.Amount = amount
+	reward.Reason = biz.RewardReasonWithdrawRefund
+	reward.Address = address
+	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
+	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	// 记账
+	err := u.postLedger(ctx, reward.Reason, fmt.Sprintf("reward:%d", reward.ID),
+		userLine(userId, amount),
+		systemLine(ledgerAccountWithdraw, amountRel.Neg()),
+		systemLine(ledgerAccountFee, amountRel.Sub(amount)),
+	)
+	if nil != err {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateWithdrawSigned 保存签名交易，状态仍为发放中
+func (u *UserRepo) UpdateWithdrawSigned(ctx context.Context, id uint64, txHash string, nonce uint64, rawTx string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status=?", "doing").
+		Updates(map[string]interface{}{
+			"tx_hash":    txHash,
+			"nonce":      nonce,
+			"raw_tx":     rawTx,
+			"sent_at":    time.Now().Format("2006-01-02 15:04:05"),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawSending 发放中 -> 已广播
+func (u *UserRepo) UpdateWithdrawSending(ctx context.Context, id uint64, txHash string, nonce uint64) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status=?", "doing").
+		Updates(map[string]interface{}{
+			"status":     biz.WithdrawStatusSending,
+			"tx_hash":    txHash,
+			"nonce":      nonce,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// UpdateWithdrawResult 已广播 -> 确认或失败
+func (u *UserRepo) UpdateWithdrawResult(ctx context.Context, id uint64, status string, blockNumber uint64) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status=?", biz.WithdrawStatusSending).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"block_number": blockNumber,
+			"updated_at":   time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+// ResetWithdrawTx 链上未出款的退回待发放，清掉旧交易，下一轮重新分配nonce签名
+func (u *UserRepo) ResetWithdrawTx(ctx context.Context, id uint64, fromStatus string, remark string) error {
+	res := u.data.DB(ctx).Table("withdraw").Where("id=?", id).Where("status=?", fromStatus).
+		Updates(map[string]interface{}{
+			"status":       biz.WithdrawStatusApproved,
+			"tx_hash":      "",
+			"nonce":        0,
+			"raw_tx":       "",
+			"block_number": 0,
+			"sent_at":      nil,
+			"remark":       remark,
+			"updated_at":   time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_WITHDRAW_ERROR", "提现记录修改失败")
+	}
+
+	return nil
+}
+
+func toBizWithdraw(withdraw *Withdraw) *biz.Withdraw {
+	return &biz.Withdraw{
+		ID:          withdraw.ID,
+		UserId:      withdraw.UserId,
+		Amount:      withdraw.Amount,
+		RelAmount:   withdraw.RelAmount,
+		Status:      withdraw.Status,
+		Address:     withdraw.Address,
+		Chain:       withdraw.Chain,
+		TxHash:      withdraw.TxHash,
+		Nonce:       withdraw.Nonce,
+		BlockNumber: withdraw.BlockNumber,
+		RawTx:       withdraw.RawTx,
+		Remark:      withdraw.Remark,
+		CreatedAt:   withdraw.CreatedAt,
+		UpdatedAt:   withdraw.UpdatedAt,
+	}
+}
+
 // CreateCardRecommend .
-func (u *UserRepo) CreateCardRecommend(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error {
+func (u *UserRepo) CreateCardRecommend(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("vip=?", vip).
 		Updates(map[string]interface{}{
 			"amount":     gorm.Expr("amount + ?", amount),
@@ -948,18 +1386,27 @@ func (u *UserRepo) CreateCardRecommend(ctx context.Context, userId uint64, amoun
 	reward.UserId = userId
 	reward.Amount = amount
 	reward.One = vip
-	reward.Reason = 6 // 给我分红的理由
+	reward.Reason = biz.RewardReasonCardBonus
 	reward.Address = address
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
+	// 记账
+	err := u.postLedger(ctx, reward.Reason, fmt.Sprintf("reward:%d", reward.ID),
+		userLine(userId, amount),
+		systemLine(ledgerAccountReward, amount.Neg()),
+	)
+	if nil != err {
+		return err
+	}
+
 	return nil
 }
 
 // CreateCardRecommendNew .
-func (u *UserRepo) CreateCardRecommendNew(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error {
+func (u *UserRepo) CreateCardRecommendNew(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
 		Updates(map[string]interface{}{
 			"amount":     gorm.Expr("amount + ?", amount),
@@ -975,18 +1422,27 @@ func (u *UserRepo) CreateCardRecommendNew(ctx context.Context, userId uint64, am
 	reward.UserId = userId
 	reward.Amount = amount
 	reward.One = vip
-	reward.Reason = 7 // 给我分红的理由
+	reward.Reason = biz.RewardReasonCardTwoActiveBonus
 	reward.Address = address
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
+	// 记账
+	err := u.postLedger(ctx, reward.Reason, fmt.Sprintf("reward:%d", reward.ID),
+		userLine(userId, amount),
+		systemLine(ledgerAccountReward, amount.Neg()),
+	)
+	if nil != err {
+		return err
+	}
+
 	return nil
 }
 
 // CreateCardRecommendTwo .
-func (u *UserRepo) CreateCardRecommendTwo(ctx context.Context, userId uint64, amount float64, vip uint64, address string) error {
+func (u *UserRepo) CreateCardRecommendTwo(ctx context.Context, userId uint64, amount money.Money, vip uint64, address string) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("vip=?", vip).
 		Updates(map[string]interface{}{
 			"amount":     gorm.Expr("amount + ?", amount),
@@ -1002,13 +1458,22 @@ func (u *UserRepo) CreateCardRecommendTwo(ctx context.Context, userId uint64, am
 	reward.UserId = userId
 	reward.Amount = amount
 	reward.One = vip
-	reward.Reason = 11 // 给我分红的理由
+	reward.Reason = biz.RewardReasonCardTwoBonus
 	reward.Address = address
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
+	// 记账
+	err := u.postLedger(ctx, reward.Reason, fmt.Sprintf("reward:%d", reward.ID),
+		userLine(userId, amount),
+		systemLine(ledgerAccountReward, amount.Neg()),
+	)
+	if nil != err {
+		return err
+	}
+
 	return nil
 }
 
@@ -1026,8 +1491,8 @@ func (u *UserRepo) UpdateCardTwo(ctx context.Context, id uint64) error {
 	return nil
 }
 
-// AmountTo .
-func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddress string, amount float64) error {
+// AmountTo 划转，转出方扣 amount，收款方加 amountRel，差额为手续费；reward.one 记对方用户id
+func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, fromAddress, toAddress string, amount, amountRel money.Money) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", amount).
 		Updates(map[string]interface{}{
 			"amount":     gorm.Expr("amount - ?", amount),
@@ -1039,7 +1504,7 @@ func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddr
 
 	resTwo := u.data.DB(ctx).Table("user").Where("id=?", toUserId).
 		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount + ?", amount),
+			"amount":     gorm.Expr("amount + ?", amountRel),
 			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
 		})
 	if resTwo.Error != nil || 0 >= resTwo.RowsAffected {
@@ -1047,23 +1512,75 @@ func (u *UserRepo) AmountTo(ctx context.Context, userId, toUserId uint64, toAddr
 	}
 
 	var (
-		reward Reward
+		reward   Reward
+		rewardIn Reward
 	)
 
 	reward.UserId = userId
-	reward.Amount = amount
-	reward.Reason = 5 // 给我分红的理由
+	reward.Amount = amountRel
+	reward.Reason = biz.RewardReasonTransfer
 	reward.Address = toAddress
+	reward.One = toUserId
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
+	fee := amount.Sub(amountRel)
+	if 0 < fee.Sign() {
+		var rewardFee Reward
+		rewardFee.UserId = userId
+		rewardFee.Amount = fee
+		rewardFee.Reason = biz.RewardReasonTransferFee
+		rewardFee.Address = toAddress
+		rewardFee.One = toUserId
+		resFee := u.data.DB(ctx).Table("reward").Create(&rewardFee)
+		if resFee.Error != nil || 0 >= resFee.RowsAffected {
+			return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+		}
+	}
+
+	rewardIn.UserId = toUserId
+	rewardIn.Amount = amountRel
+	rewardIn.Reason = biz.RewardReasonTransferIn
+	rewardIn.Address = fromAddress
+	rewardIn.One = userId
+	resIn := u.data.DB(ctx).Table("reward").Create(&rewardIn)
+	if resIn.Error != nil || 0 >= resIn.RowsAffected {
+		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+	}
+
+	// 记账
+	err := u.postLedger(ctx, reward.Reason, fmt.Sprintf("reward:%d", reward.ID),
+		userLine(userId, amount.Neg()),
+		userLine(toUserId, amountRel),
+		systemLine(ledgerAccountFee, fee),
+	)
+	if nil != err {
+		return err
+	}
+
 	return nil
 }
 
+// GetUserTransferTotal 转出方since之后的划转合计（含手续费）和笔数
+func (u *UserRepo) GetUserTransferTotal(ctx context.Context, userId uint64, since time.Time) (money.Money, int64, error) {
+	var total struct {
+		Amount money.Money
+		Count  int64
+	}
+	if err := u.data.DB(ctx).Table("reward").
+		Select("IFNULL(sum(amount),0) as amount, IFNULL(sum(reason=?),0) as count", biz.RewardReasonTransfer).
+		Where("user_id=?", userId).Where("reason IN ?", []uint64{biz.RewardReasonTransfer, biz.RewardReasonTransferFee}).
+		Where("created_at>=?", since).Scan(&total).Error; err != nil {
+		return money.Zero(), 0, errors.New(500, "REWARD ERROR", err.Error())
+	}
+
+	return total.Amount, total.Count, nil
+}
+
 // Withdraw .
-func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRel float64, address string) error {
+func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRel money.Money, address string, chain string, status string, remark string) error {
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).Where("amount>=?", amount).
 		Updates(map[string]interface{}{
 			"amount":     gorm.Expr("amount - ?", amount),
@@ -1077,8 +1594,10 @@ func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRe
 	withdraw.UserId = userId
 	withdraw.Amount = amount
 	withdraw.RelAmount = amountRel
-	withdraw.Status = "rewarded"
+	withdraw.Status = status
 	withdraw.Address = address
+	withdraw.Chain = chain
+	withdraw.Remark = remark
 	resTwo := u.data.DB(ctx).Table("withdraw").Create(&withdraw)
 	if resTwo.Error != nil || 0 >= resTwo.RowsAffected {
 		return errors.New(500, "CREATE_WITHDRAW_ERROR", "提现记录创建失败")
@@ -1089,14 +1608,37 @@ func (u *UserRepo) Withdraw(ctx context.Context, userId uint64, amount, amountRe
 	)
 
 	reward.UserId = userId
-	reward.Amount = amount
-	reward.Reason = 2 // 给我分红的理由
+	reward.Amount = amountRel
+	reward.Reason = biz.RewardReasonWithdraw
 	reward.Address = address
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
+	// 手续费单独记一条
+	if amount.GreaterThan(amountRel) {
+		var rewardFee Reward
+		rewardFee.UserId = userId
+		rewardFee.Amount = amount.Sub(amountRel)
+		rewardFee.Reason = biz.RewardReasonWithdrawFee
+		rewardFee.Address = address
+		resInsert = u.data.DB(ctx).Table("reward").Create(&rewardFee)
+		if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+			return errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
+		}
+	}
+
+	// 记账，到账金额转出，手续费入收入
+	err := u.postLedger(ctx, biz.RewardReasonWithdraw, fmt.Sprintf("withdraw:%d", withdraw.ID),
+		userLine(userId, amount.Neg()),
+		systemLine(ledgerAccountWithdraw, amountRel),
+		systemLine(ledgerAccountFee, amount.Sub(amountRel)),
+	)
+	if nil != err {
+		return err
+	}
+
 	return nil
 }
 
@@ -1147,16 +1689,102 @@ func (u *UserRepo) GetUserRewardByUserIdPage(ctx context.Context, b *biz.Paginat
 func (u *UserRepo) GetEthUserRecordLast() (int64, error) {
 	var ethUserRecord *EthUserRecord
 	if err := u.data.db.Table("eth_user_record").Order("last desc").First(&ethUserRecord).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return -1, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+		}
+
+		ethUserRecord = &EthUserRecord{}
+	}
+
+	// 未入账的充值也推进下标
+	var pending *DepositPending
+	if err := u.data.db.Table("deposit_pending").Order("last desc").First(&pending).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return 0, nil
+			return ethUserRecord.Last, nil
 		}
 
-		return -1, errors.New(500, "USER RECOMMEND ERROR", err.Error())
+		return -1, errors.New(500, "DEPOSIT PENDING ERROR", err.Error())
+	}
+
+	if pending.Last > ethUserRecord.Last {
+		return pending.Last, nil
 	}
 
 	return ethUserRecord.Last, nil
 }
 
+// HasDepositKeyPrefix 充值记录或待处理充值中是否有指定前缀的去重键
+func (u *UserRepo) HasDepositKeyPrefix(prefixes ...string) (bool, error) {
+	for _, table := range []string{"eth_user_record", "deposit_pending"} {
+		for _, prefix := range prefixes {
+			var count int64
+			if err := u.data.db.Table(table).Where("deposit_key LIKE ?", prefix+"%").Limit(1).Count(&count).Error; err != nil {
+				return false, errors.New(500, "DEPOSIT KEY ERROR", err.Error())
+			}
+
+			if 0 < count {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// GetDepositCheckpoint .
+func (u *UserRepo) GetDepositCheckpoint(name string) (*biz.DepositCheckpoint, error) {
+	var checkpoint DepositCheckpoint
+	if err := u.data.db.Table("deposit_checkpoint").Where("name=?", name).First(&checkpoint).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "DEPOSIT CHECKPOINT ERROR", err.Error())
+	}
+
+	return &biz.DepositCheckpoint{
+		ID:          checkpoint.ID,
+		Name:        checkpoint.Name,
+		BlockNumber: checkpoint.BlockNumber,
+		BlockHash:   checkpoint.BlockHash,
+		CreatedAt:   checkpoint.CreatedAt,
+		UpdatedAt:   checkpoint.UpdatedAt,
+	}, nil
+}
+
+// SetDepositCheckpoint 记录已扫描到的区块，没有则新建
+func (u *UserRepo) SetDepositCheckpoint(ctx context.Context, name string, blockNumber uint64, blockHash string) error {
+	var checkpoint DepositCheckpoint
+	err := u.data.DB(ctx).Table("deposit_checkpoint").Where("name=?", name).First(&checkpoint).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.New(500, "DEPOSIT CHECKPOINT ERROR", err.Error())
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		checkpoint.Name = name
+		checkpoint.BlockNumber = blockNumber
+		checkpoint.BlockHash = blockHash
+		resInsert := u.data.DB(ctx).Table("deposit_checkpoint").Create(&checkpoint)
+		if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+			return errors.New(500, "CREATE_DEPOSIT_CHECKPOINT_ERROR", "扫块进度创建失败")
+		}
+
+		return nil
+	}
+
+	res := u.data.DB(ctx).Table("deposit_checkpoint").Where("id=?", checkpoint.ID).
+		Updates(map[string]interface{}{
+			"block_number": blockNumber,
+			"block_hash":   blockHash,
+			"updated_at":   time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_DEPOSIT_CHECKPOINT_ERROR", "扫块进度修改失败")
+	}
+
+	return nil
+}
+
 // GetUserByAddresses .
 func (u *UserRepo) GetUserByAddresses(addresses ...string) (map[string]*biz.User, error) {
 	var users []*User
@@ -1179,9 +1807,32 @@ func (u *UserRepo) GetUserByAddresses(addresses ...string) (map[string]*biz.User
 }
 
 func (u *UserRepo) CreateEthUserRecordListByHash(ctx context.Context, r *biz.EthUserRecord) (*biz.EthUserRecord, error) {
+	// 先写充值记录，deposit_key 唯一，重复入账在这里失败
+	var ethUserRecord EthUserRecord
+	ethUserRecord.UserId = r.UserId
+	ethUserRecord.Hash = r.Hash
+	ethUserRecord.Amount = r.Amount
+	ethUserRecord.AmountTwo = r.AmountTwo
+	ethUserRecord.RelAmount = r.RelAmount
+	ethUserRecord.Last = r.Last
+	ethUserRecord.Chain = r.Chain
+	ethUserRecord.DepositKey = r.DepositKey
+
+	resTwo := u.data.DB(ctx).Table("eth_user_record").Create(&ethUserRecord)
+	if resTwo.Error != nil {
+		if isDuplicateEntry(resTwo.Error) {
+			return nil, errors.Conflict("DEPOSIT_CREDITED", "充值已入账")
+		}
+
+		return nil, errors.New(500, "CREATE_ETH_USER_RECORD_ERROR", "以太坊交易信息创建失败")
+	}
+	if 0 >= resTwo.RowsAffected {
+		return nil, errors.New(500, "CREATE_ETH_USER_RECORD_ERROR", "以太坊交易信息创建失败")
+	}
+
 	res := u.data.DB(ctx).Table("user").Where("id=?", r.UserId).
 		Updates(map[string]interface{}{
-			"amount":     gorm.Expr("amount + ?", float64(r.AmountTwo)),
+			"amount":     gorm.Expr("amount + ?", r.RelAmount),
 			"amount_two": gorm.Expr("amount_two + ?", r.AmountTwo),
 			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
 		})
@@ -1192,40 +1843,44 @@ func (u *UserRepo) CreateEthUserRecordListByHash(ctx context.Context, r *biz.Eth
 		reward Reward
 	)
 	reward.UserId = uint64(r.UserId)
-	reward.Amount = float64(r.AmountTwo)
-	reward.Reason = 1 // 给我分红的理由
+	reward.Amount = r.RelAmount
+	reward.Reason = biz.RewardReasonDeposit
 	resInsert := u.data.DB(ctx).Table("reward").Create(&reward)
 	if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
 		return nil, errors.New(500, "CREATE_LOCATION_ERROR", "信息创建失败")
 	}
 
-	var ethUserRecord EthUserRecord
-	ethUserRecord.UserId = r.UserId
-	ethUserRecord.Hash = r.Hash
-	ethUserRecord.Amount = r.Amount
-	ethUserRecord.AmountTwo = r.AmountTwo
-	ethUserRecord.Last = r.Last
-
-	resTwo := u.data.DB(ctx).Table("eth_user_record").Create(&ethUserRecord)
-	if resTwo.Error != nil || 0 >= resTwo.RowsAffected {
-		return nil, errors.New(500, "CREATE_ETH_USER_RECORD_ERROR", "以太坊交易信息创建失败")
+	// 记账
+	err := u.postLedger(ctx, reward.Reason, fmt.Sprintf("deposit:%s", r.DepositKey),
+		userLine(uint64(r.UserId), r.RelAmount),
+		systemLine(ledgerAccountDeposit, r.RelAmount.Neg()),
+	)
+	if nil != err {
+		return nil, err
 	}
 
 	return &biz.EthUserRecord{
-		ID:        ethUserRecord.ID,
-		UserId:    ethUserRecord.UserId,
-		Hash:      ethUserRecord.Hash,
-		Amount:    ethUserRecord.Amount,
-		AmountTwo: ethUserRecord.AmountTwo,
-		Last:      ethUserRecord.Last,
+		ID:         ethUserRecord.ID,
+		UserId:     ethUserRecord.UserId,
+		Hash:       ethUserRecord.Hash,
+		Amount:     ethUserRecord.Amount,
+		AmountTwo:  ethUserRecord.AmountTwo,
+		RelAmount:  ethUserRecord.RelAmount,
+		Last:       ethUserRecord.Last,
+		Chain:      ethUserRecord.Chain,
+		DepositKey: ethUserRecord.DepositKey,
 	}, nil
 }
 
-// UpdateUserMyTotalAmountAdd .
+// UpdateUserMyTotalAmountAdd 业绩为有符号bigint，累加超出按MaxInt64截断
 func (u *UserRepo) UpdateUserMyTotalAmountAdd(ctx context.Context, userId uint64, amount uint64) error {
+	if amount > math.MaxInt64 {
+		amount = math.MaxInt64
+	}
+
 	res := u.data.DB(ctx).Table("user").Where("id=?", userId).
 		Updates(map[string]interface{}{
-			"my_total_amount": gorm.Expr("my_total_amount + ?", amount),
+			"my_total_amount": gorm.Expr("IF(my_total_amount > ?, ?, my_total_amount + ?)", uint64(math.MaxInt64)-amount, uint64(math.MaxInt64), amount),
 			"updated_at":      time.Now().Format("2006-01-02 15:04:05"),
 		})
 	if res.Error != nil || 0 >= res.RowsAffected {
@@ -1235,6 +1890,49 @@ func (u *UserRepo) UpdateUserMyTotalAmountAdd(ctx context.Context, userId uint64
 	return nil
 }
 
+// GetEthUserRecordTotalByUser 每个用户已入账充值合计
+func (u *UserRepo) GetEthUserRecordTotalByUser() (map[uint64]uint64, error) {
+	var totals []*struct {
+		UserId uint64
+		Total  uint64
+	}
+	if err := u.data.db.Table("eth_user_record").Select("user_id, LEAST(sum(amount_two), ?) as total", uint64(math.MaxInt64)).Group("user_id").Scan(&totals).Error; err != nil {
+		return nil, errors.New(500, "ETH USER RECORD ERROR", err.Error())
+	}
+
+	res := make(map[uint64]uint64, 0)
+	for _, v := range totals {
+		res[v.UserId] = v.Total
+	}
+
+	return res, nil
+}
+
+// ResetUserMyTotalAmount 业绩清零后按重算结果写入
+func (u *UserRepo) ResetUserMyTotalAmount(ctx context.Context, totals map[uint64]uint64) error {
+	res := u.data.DB(ctx).Table("user").Where("my_total_amount<>?", 0).
+		Updates(map[string]interface{}{
+			"my_total_amount": 0,
+			"updated_at":      time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+	}
+
+	for userId, amount := range totals {
+		res = u.data.DB(ctx).Table("user").Where("id=?", userId).
+			Updates(map[string]interface{}{
+				"my_total_amount": amount,
+				"updated_at":      time.Now().Format("2006-01-02 15:04:05"),
+			})
+		if res.Error != nil {
+			return errors.New(500, "UPDATE_USER_ERROR", "用户信息修改失败")
+		}
+	}
+
+	return nil
+}
+
 // InsertCardRecord .
 func (u *UserRepo) InsertCardRecord(ctx context.Context, userId, recordType uint64, remark string, code string, opt string) error {
 	var (
@@ -1263,7 +1961,7 @@ func (u *UserRepo) GetUserCardTwo() ([]*biz.Reward, error) {
 	res := make([]*biz.Reward, 0)
 
 	instance := u.data.db.Table("reward").Order("id asc")
-	instance = instance.Where("reason=?", 9).Where("one=?", 0)
+	instance = instance.Where("reason=?", biz.RewardReasonOpenCardTwo).Where("one=?", 0)
 	if err := instance.Find(&rewards).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return res, nil
@@ -1429,3 +2127,310 @@ func (u *UserRepo) UpdateConfig(ctx context.Context, id int64, value string) (bo
 
 	return true, nil
 }
+
+// GetCardOrders .
+func (u *UserRepo) GetCardOrders(orderType uint64, status ...string) ([]*biz.CardOrder, error) {
+	var cardOrders []*CardOrder
+	res := make([]*biz.CardOrder, 0)
+	if err := u.data.db.Table("card_order").Where("order_type=?", orderType).Where("status IN (?)", status).Order("id asc").Find(&cardOrders).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "CARD ORDER ERROR", err.Error())
+	}
+
+	for _, cardOrder := range cardOrders {
+		res = append(res, toBizCardOrder(cardOrder))
+	}
+
+	return res, nil
+}
+
+// GetCardOrderById .
+func (u *UserRepo) GetCardOrderById(id uint64) (*biz.CardOrder, error) {
+	var cardOrder CardOrder
+	if err := u.data.db.Table("card_order").Where("id=?", id).First(&cardOrder).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "CARD ORDER ERROR", err.Error())
+	}
+
+	return toBizCardOrder(&cardOrder), nil
+}
+
+// GetCardOrderPage .
+func (u *UserRepo) GetCardOrderPage(b *biz.Pagination, status string) ([]*biz.CardOrder, error, int64) {
+	var (
+		cardOrders []*CardOrder
+		count      int64
+	)
+	res := make([]*biz.CardOrder, 0)
+
+	instance := u.data.db.Table("card_order").Where("status=?", status)
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&cardOrders).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "CARD ORDER ERROR", err.Error()), 0
+	}
+
+	for _, cardOrder := range cardOrders {
+		res = append(res, toBizCardOrder(cardOrder))
+	}
+
+	return res, nil, count
+}
+
+// AddCardOrderAttempt 处理中的订单查询次数加一，没有则新建
+func (u *UserRepo) AddCardOrderAttempt(ctx context.Context, userId, orderType uint64, orderNo string, deadline time.Time) (*biz.CardOrder, error) {
+	var cardOrder CardOrder
+	err := u.data.DB(ctx).Table("card_order").
+		Where("user_id=?", userId).Where("order_type=?", orderType).Where("order_no=?", orderNo).Where("status=?", "doing").
+		Order("id desc").First(&cardOrder).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New(500, "CARD ORDER ERROR", err.Error())
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		cardOrder.UserId = userId
+		cardOrder.OrderType = orderType
+		cardOrder.OrderNo = orderNo
+		cardOrder.Attempts = 1
+		cardOrder.Status = "doing"
+		cardOrder.Deadline = deadline
+		resInsert := u.data.DB(ctx).Table("card_order").Create(&cardOrder)
+		if resInsert.Error != nil || 0 >= resInsert.RowsAffected {
+			return nil, errors.New(500, "CREATE_CARD_ORDER_ERROR", "开卡订单创建失败")
+		}
+
+		return toBizCardOrder(&cardOrder), nil
+	}
+
+	res := u.data.DB(ctx).Table("card_order").Where("id=?", cardOrder.ID).
+		Updates(map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + ?", 1),
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return nil, errors.New(500, "UPDATE_CARD_ORDER_ERROR", "开卡订单修改失败")
+	}
+
+	cardOrder.Attempts++
+	return toBizCardOrder(&cardOrder), nil
+}
+
+// UpdateCardOrderStatus 按原状态修改，防止重复处理
+func (u *UserRepo) UpdateCardOrderStatus(ctx context.Context, id uint64, fromStatus, status, remark string) error {
+	res := u.data.DB(ctx).Table("card_order").Where("id=?", id).Where("status=?", fromStatus).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"remark":     remark,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_ORDER_ERROR", "开卡订单修改失败")
+	}
+
+	return nil
+}
+
+// ResetCardOrder 重新开始计数
+func (u *UserRepo) ResetCardOrder(ctx context.Context, id uint64, fromStatus string, deadline time.Time) error {
+	res := u.data.DB(ctx).Table("card_order").Where("id=?", id).Where("status=?", fromStatus).
+		Updates(map[string]interface{}{
+			"status":     "doing",
+			"attempts":   0,
+			"remark":     "人工重试",
+			"deadline":   deadline,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_CARD_ORDER_ERROR", "开卡订单修改失败")
+	}
+
+	return nil
+}
+
+// CloseCardOrder 订单有结果，关闭处理中和死信的记录
+func (u *UserRepo) CloseCardOrder(ctx context.Context, userId, orderType uint64, status string) error {
+	res := u.data.DB(ctx).Table("card_order").
+		Where("user_id=?", userId).Where("order_type=?", orderType).Where("status IN (?)", []string{"doing", "dead"}).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil {
+		return errors.New(500, "UPDATE_CARD_ORDER_ERROR", "开卡订单修改失败")
+	}
+
+	return nil
+}
+
+func toBizCardOrder(cardOrder *CardOrder) *biz.CardOrder {
+	return &biz.CardOrder{
+		ID:        cardOrder.ID,
+		UserId:    cardOrder.UserId,
+		OrderType: cardOrder.OrderType,
+		OrderNo:   cardOrder.OrderNo,
+		Attempts:  cardOrder.Attempts,
+		Status:    cardOrder.Status,
+		Remark:    cardOrder.Remark,
+		Deadline:  cardOrder.Deadline,
+		CreatedAt: cardOrder.CreatedAt,
+		UpdatedAt: cardOrder.UpdatedAt,
+	}
+}
+
+// CreateDepositPending deposit_key 唯一，重复记录返回冲突
+func (u *UserRepo) CreateDepositPending(ctx context.Context, d *biz.DepositPending) error {
+	var pending DepositPending
+	pending.UserId = d.UserId
+	pending.Address = d.Address
+	pending.Chain = d.Chain
+	pending.Hash = d.Hash
+	pending.DepositKey = d.DepositKey
+	pending.Amount = d.Amount
+	pending.AmountTwo = d.AmountTwo
+	pending.RelAmount = d.RelAmount
+	pending.Last = d.Last
+	pending.Status = d.Status
+	pending.Remark = d.Remark
+
+	res := u.data.DB(ctx).Table("deposit_pending").Create(&pending)
+	if res.Error != nil {
+		if isDuplicateEntry(res.Error) {
+			return errors.Conflict("DEPOSIT_CREDITED", "充值已入账")
+		}
+
+		return errors.New(500, "CREATE_DEPOSIT_PENDING_ERROR", "充值记录创建失败")
+	}
+	if 0 >= res.RowsAffected {
+		return errors.New(500, "CREATE_DEPOSIT_PENDING_ERROR", "充值记录创建失败")
+	}
+
+	return nil
+}
+
+// GetDepositPendingById .
+func (u *UserRepo) GetDepositPendingById(id uint64) (*biz.DepositPending, error) {
+	var pending DepositPending
+	if err := u.data.db.Table("deposit_pending").Where("id=?", id).First(&pending).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.New(500, "DEPOSIT PENDING ERROR", err.Error())
+	}
+
+	return toBizDepositPending(&pending), nil
+}
+
+// GetRegisteredDepositPendings 只取地址已注册的记录，未注册的不占批次
+func (u *UserRepo) GetRegisteredDepositPendings(status string, limit int) ([]*biz.DepositPending, error) {
+	var pendings []*DepositPending
+	res := make([]*biz.DepositPending, 0)
+	if err := u.data.db.Table("deposit_pending").Where("status=?", status).
+		Where("address IN (?)", u.data.db.Table("user").Select("address")).
+		Order("id asc").Limit(limit).Find(&pendings).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "DEPOSIT PENDING ERROR", err.Error())
+	}
+
+	for _, pending := range pendings {
+		res = append(res, toBizDepositPending(pending))
+	}
+
+	return res, nil
+}
+
+// GetDepositPendingsByAddress .
+func (u *UserRepo) GetDepositPendingsByAddress(address string, status string) ([]*biz.DepositPending, error) {
+	var pendings []*DepositPending
+	res := make([]*biz.DepositPending, 0)
+	if err := u.data.db.Table("deposit_pending").Where("address=?", address).Where("status=?", status).Order("id asc").Find(&pendings).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+
+		return nil, errors.New(500, "DEPOSIT PENDING ERROR", err.Error())
+	}
+
+	for _, pending := range pendings {
+		res = append(res, toBizDepositPending(pending))
+	}
+
+	return res, nil
+}
+
+// GetDepositPendingPage .
+func (u *UserRepo) GetDepositPendingPage(b *biz.Pagination, status string, address string) ([]*biz.DepositPending, error, int64) {
+	var (
+		pendings []*DepositPending
+		count    int64
+	)
+	res := make([]*biz.DepositPending, 0)
+
+	instance := u.data.db.Table("deposit_pending").Where("status=?", status)
+	if "" != address {
+		instance = instance.Where("address=?", address)
+	}
+
+	instance = instance.Count(&count)
+	if err := instance.Scopes(Paginate(b.PageNum, b.PageSize)).Order("id desc").Find(&pendings).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return res, nil, 0
+		}
+
+		return nil, errors.New(500, "DEPOSIT PENDING ERROR", err.Error()), 0
+	}
+
+	for _, pending := range pendings {
+		res = append(res, toBizDepositPending(pending))
+	}
+
+	return res, nil, count
+}
+
+// UpdateDepositPendingStatus 按原状态修改，防止重复处理
+func (u *UserRepo) UpdateDepositPendingStatus(ctx context.Context, id uint64, fromStatus, status string, userId uint64, remark string) error {
+	res := u.data.DB(ctx).Table("deposit_pending").Where("id=?", id).Where("status=?", fromStatus).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"user_id":    userId,
+			"remark":     remark,
+			"updated_at": time.Now().Format("2006-01-02 15:04:05"),
+		})
+	if res.Error != nil || 0 >= res.RowsAffected {
+		return errors.New(500, "UPDATE_DEPOSIT_PENDING_ERROR", "充值记录修改失败")
+	}
+
+	return nil
+}
+
+func toBizDepositPending(pending *DepositPending) *biz.DepositPending {
+	return &biz.DepositPending{
+		ID:         pending.ID,
+		UserId:     pending.UserId,
+		Address:    pending.Address,
+		Chain:      pending.Chain,
+		Hash:       pending.Hash,
+		DepositKey: pending.DepositKey,
+		Amount:     pending.Amount,
+		AmountTwo:  pending.AmountTwo,
+		RelAmount:  pending.RelAmount,
+		Last:       pending.Last,
+		Status:     pending.Status,
+		Remark:     pending.Remark,
+		CreatedAt:  pending.CreatedAt,
+		UpdatedAt:  pending.UpdatedAt,
+	}
+}
@@ -0,0 +1,238 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimals 定点精度，和链上18位代币一致；数据库 decimal(65,20) 多出的位数截断
+const Decimals = 18
+
+var unit = new(big.Int).Exp(big.NewInt(10), big.NewInt(Decimals), nil)
+
+// Money 定点金额，内部为 值*10^18 的整数，零值即0，运算不修改原值
+type Money struct {
+	v *big.Int
+}
+
+func Zero() Money {
+	return Money{}
+}
+
+func FromInt(n int64) Money {
+	return Money{v: new(big.Int).Mul(big.NewInt(n), unit)}
+}
+
+func FromUint(n uint64) Money {
+	return Money{v: new(big.Int).Mul(new(big.Int).SetUint64(n), unit)}
+}
+
+// FromFloat 仅用于兼容旧的浮点入参，按最短十进制表示转换
+func FromFloat(f float64) Money {
+	m, _ := Parse(strconv.FormatFloat(f, 'f', -1, 64))
+	return m
+}
+
+// FromWei 链上最小单位转金额
+func FromWei(value *big.Int, decimals uint8) Money {
+	if nil == value {
+		return Money{}
+	}
+
+	v := new(big.Int).Set(value)
+	if decimals < Decimals {
+		v.Mul(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(Decimals-decimals)), nil))
+	} else if decimals > Decimals {
+		v.Quo(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals-Decimals)), nil))
+	}
+
+	return Money{v: v}
+}
+
+// Parse 解析十进制字符串，超过18位小数截断
+func Parse(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if "" == s {
+		return Money{}, fmt.Errorf("money: empty string")
+	}
+
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	integerPart, decimalPart := s, ""
+	if i := strings.Index(s, "."); i >= 0 {
+		integerPart, decimalPart = s[:i], s[i+1:]
+	}
+	if "" == integerPart && "" == decimalPart {
+		return Money{}, fmt.Errorf("money: invalid amount %q", s)
+	}
+	if "" == integerPart {
+		integerPart = "0"
+	}
+	if len(decimalPart) > Decimals {
+		decimalPart = decimalPart[:Decimals]
+	}
+	decimalPart += strings.Repeat("0", Decimals-len(decimalPart))
+
+	v, ok := new(big.Int).SetString(integerPart+decimalPart, 10)
+	if !ok || 0 > v.Sign() {
+		return Money{}, fmt.Errorf("money: invalid amount %q", s)
+	}
+	if neg {
+		v.Neg(v)
+	}
+
+	return Money{v: v}, nil
+}
+
+func (m Money) int() *big.Int {
+	if nil == m.v {
+		return new(big.Int)
+	}
+
+	return m.v
+}
+
+func (m Money) Add(o Money) Money {
+	return Money{v: new(big.Int).Add(m.int(), o.int())}
+}
+
+func (m Money) Sub(o Money) Money {
+	return Money{v: new(big.Int).Sub(m.int(), o.int())}
+}
+
+// Mul 乘以比例或数量，结果向零截断
+func (m Money) Mul(o Money) Money {
+	v := new(big.Int).Mul(m.int(), o.int())
+	return Money{v: v.Quo(v, unit)}
+}
+
+func (m Money) Neg() Money {
+	return Money{v: new(big.Int).Neg(m.int())}
+}
+
+func (m Money) Cmp(o Money) int {
+	return m.int().Cmp(o.int())
+}
+
+func (m Money) Sign() int {
+	return m.int().Sign()
+}
+
+func (m Money) IsZero() bool {
+	return 0 == m.Sign()
+}
+
+func (m Money) LessThan(o Money) bool {
+	return 0 > m.Cmp(o)
+}
+
+func (m Money) GreaterThan(o Money) bool {
+	return 0 < m.Cmp(o)
+}
+
+// Wei 转链上最小单位，精度不足截断
+func (m Money) Wei(decimals uint8) *big.Int {
+	v := new(big.Int).Set(m.int())
+	if decimals < Decimals {
+		v.Quo(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(Decimals-decimals)), nil))
+	} else if decimals > Decimals {
+		v.Mul(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals-Decimals)), nil))
+	}
+
+	return v
+}
+
+// Uint64 整数部分，负数为0
+func (m Money) Uint64() uint64 {
+	if 0 >= m.Sign() {
+		return 0
+	}
+
+	return new(big.Int).Quo(m.int(), unit).Uint64()
+}
+
+// Float64 仅用于展示和比例配置，不参与记账
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.String(), 64)
+	return f
+}
+
+// String 十进制表示，去掉末尾的0
+func (m Money) String() string {
+	s := m.StringFixed(Decimals)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s
+}
+
+// StringFixed 保留places位小数，多余截断
+func (m Money) StringFixed(places int) string {
+	if places > Decimals {
+		places = Decimals
+	}
+	if 0 > places {
+		places = 0
+	}
+
+	abs := new(big.Int).Abs(m.int())
+	whole, frac := new(big.Int).QuoRem(abs, unit, new(big.Int))
+
+	s := whole.String()
+	if 0 < places {
+		fracStr := frac.String()
+		fracStr = strings.Repeat("0", Decimals-len(fracStr)) + fracStr
+		s += "." + fracStr[:places]
+	}
+	if 0 > m.Sign() && strings.Trim(s, "0.") != "" {
+		s = "-" + s
+	}
+
+	return s
+}
+
+// Scan 读取数据库 decimal
+func (m *Money) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case []byte:
+		tmp, err := Parse(string(v))
+		if err != nil {
+			return err
+		}
+		*m = tmp
+		return nil
+	case string:
+		tmp, err := Parse(v)
+		if err != nil {
+			return err
+		}
+		*m = tmp
+		return nil
+	case int64:
+		*m = FromInt(v)
+		return nil
+	case float64:
+		*m = FromFloat(v)
+		return nil
+	}
+
+	return fmt.Errorf("money: cannot scan %T", value)
+}
+
+// Value 按十进制字符串写入，数据库端用 decimal 计算，不经过浮点
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
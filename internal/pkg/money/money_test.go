@@ -0,0 +1,214 @@
+package money
+
+import (
+	"math/big"
+	"testing"
+)
+
+func mustParse(t *testing.T, s string) Money {
+	t.Helper()
+	m, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", s, err)
+	}
+	return m
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "0", want: "0"},
+		{in: "1", want: "1"},
+		{in: "+1.5", want: "1.5"},
+		{in: "-1.5", want: "-1.5"},
+		{in: "-0", want: "0"},
+		{in: ".5", want: "0.5"},
+		{in: "-.5", want: "-0.5"},
+		{in: "5.", want: "5"},
+		{in: " 12.34 ", want: "12.34"},
+		{in: "0.000000000000000001", want: "0.000000000000000001"},
+		{in: "0.0000000000000000019", want: "0.000000000000000001"},
+		{in: "-1.1234567890123456789", want: "-1.123456789012345678"},
+		{in: "123456789012345678901234567890", want: "123456789012345678901234567890"},
+		{in: "", wantErr: true},
+		{in: "   ", wantErr: true},
+		{in: ".", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: "--1", wantErr: true},
+		{in: "1.2.3", wantErr: true},
+		{in: "1e5", wantErr: true},
+		{in: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Parse(%q) = %s, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFromWeiAndWei(t *testing.T) {
+	tests := []struct {
+		wei      string
+		decimals uint8
+		want     string
+		back     string
+	}{
+		{wei: "1", decimals: 6, want: "0.000001", back: "1"},
+		{wei: "1234567", decimals: 6, want: "1.234567", back: "1234567"},
+		{wei: "-1500000", decimals: 6, want: "-1.5", back: "-1500000"},
+		{wei: "1", decimals: 18, want: "0.000000000000000001", back: "1"},
+		{wei: "1000000000000000000", decimals: 18, want: "1", back: "1000000000000000000"},
+		{wei: "1000000000000000000000000", decimals: 24, want: "1", back: "1000000000000000000000000"},
+		// 超过18位的部分截断，转回时补0
+		{wei: "1234567000000", decimals: 24, want: "0.000000000001234567", back: "1234567000000"},
+		{wei: "1234567", decimals: 24, want: "0.000000000000000001", back: "1000000"},
+		{wei: "999999", decimals: 24, want: "0", back: "0"},
+	}
+
+	for _, tt := range tests {
+		v, _ := new(big.Int).SetString(tt.wei, 10)
+		m := FromWei(v, tt.decimals)
+		if m.String() != tt.want {
+			t.Errorf("FromWei(%s, %d) = %s, want %s", tt.wei, tt.decimals, m, tt.want)
+		}
+		if got := m.Wei(tt.decimals).String(); got != tt.back {
+			t.Errorf("FromWei(%s, %d).Wei() = %s, want %s", tt.wei, tt.decimals, got, tt.back)
+		}
+	}
+
+	if !FromWei(nil, 18).IsZero() {
+		t.Errorf("FromWei(nil) should be zero")
+	}
+}
+
+func TestWeiTruncates(t *testing.T) {
+	tests := []struct {
+		in       string
+		decimals uint8
+		want     string
+	}{
+		{in: "1.2345679", decimals: 6, want: "1234567"},
+		{in: "-1.2345679", decimals: 6, want: "-1234567"},
+		{in: "0.0000009", decimals: 6, want: "0"},
+		{in: "1.5", decimals: 18, want: "1500000000000000000"},
+		{in: "1.5", decimals: 24, want: "1500000000000000000000000"},
+		{in: "0", decimals: 24, want: "0"},
+	}
+
+	for _, tt := range tests {
+		if got := mustParse(t, tt.in).Wei(tt.decimals).String(); got != tt.want {
+			t.Errorf("Parse(%q).Wei(%d) = %s, want %s", tt.in, tt.decimals, got, tt.want)
+		}
+	}
+}
+
+func TestMul(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{a: "100", b: "0.05", want: "5"},
+		{a: "3", b: "0.333333333333333333", want: "0.999999999999999999"},
+		// 结果向零截断
+		{a: "0.000000000000000001", b: "0.5", want: "0"},
+		{a: "-0.000000000000000001", b: "0.5", want: "0"},
+		{a: "1.000000000000000001", b: "0.999999999999999999", want: "0.999999999999999999"},
+		{a: "-10", b: "0.123", want: "-1.23"},
+		{a: "12345", b: "0", want: "0"},
+	}
+
+	for _, tt := range tests {
+		if got := mustParse(t, tt.a).Mul(mustParse(t, tt.b)).String(); got != tt.want {
+			t.Errorf("%s * %s = %s, want %s", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestStringFixed(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int
+		want   string
+	}{
+		{in: "1.23456", places: 2, want: "1.23"},
+		{in: "-1.239", places: 2, want: "-1.23"},
+		{in: "-0.001", places: 2, want: "0.00"},
+		{in: "5", places: 0, want: "5"},
+		{in: "5", places: 30, want: "5.000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		if got := mustParse(t, tt.in).StringFixed(tt.places); got != tt.want {
+			t.Errorf("Parse(%q).StringFixed(%d) = %s, want %s", tt.in, tt.places, got, tt.want)
+		}
+	}
+}
+
+func TestScanValue(t *testing.T) {
+	tests := []string{
+		"0",
+		"1",
+		"-1.5",
+		"0.000000000000000001",
+		"123456789012345678901234567890.123456789012345678",
+	}
+
+	for _, s := range tests {
+		m := mustParse(t, s)
+		v, err := m.Value()
+		if err != nil {
+			t.Fatalf("Value(%s): %v", s, err)
+		}
+
+		var fromString, fromBytes Money
+		if err := fromString.Scan(v); err != nil {
+			t.Fatalf("Scan(%v): %v", v, err)
+		}
+		if err := fromBytes.Scan([]byte(v.(string))); err != nil {
+			t.Fatalf("Scan([]byte %v): %v", v, err)
+		}
+		if 0 != fromString.Cmp(m) || 0 != fromBytes.Cmp(m) {
+			t.Errorf("round trip %s: got %s / %s", s, fromString, fromBytes)
+		}
+	}
+
+	// decimal(65,20) 读出多出的两位截断
+	var m Money
+	if err := m.Scan([]byte("1.12345678901234567890")); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if m.String() != "1.123456789012345678" {
+		t.Errorf("Scan decimal(65,20) = %s", m)
+	}
+
+	if err := m.Scan(nil); err != nil || !m.IsZero() {
+		t.Errorf("Scan(nil) = %s, %v", m, err)
+	}
+	if err := m.Scan(int64(7)); err != nil || m.String() != "7" {
+		t.Errorf("Scan(int64) = %s, %v", m, err)
+	}
+	if err := m.Scan(true); err == nil {
+		t.Errorf("Scan(bool) should fail")
+	}
+
+	// 零值写库为"0"
+	v, err := Zero().Value()
+	if err != nil || v != "0" {
+		t.Errorf("Zero().Value() = %v, %v", v, err)
+	}
+}
@@ -5,10 +5,13 @@ import (
 	"cardbinance/internal/conf"
 	"cardbinance/internal/service"
 	"context"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	jwt2 "github.com/golang-jwt/jwt/v5"
 	"github.com/gorilla/handlers"
@@ -23,6 +26,7 @@ func NewHTTPServer(c *conf.Server, userService *service.UserService, logger log.
 				jwt.Server(func(token *jwt2.Token) (interface{}, error) {
 					return []byte("5485c6f09a1a9bf5edeb841d85e09250"), nil
 				}, jwt.WithSigningMethod(jwt2.SigningMethodHS256)),
+				UserTypeCheck(),
 			).Match(NewWhiteListMatcher()).Build(),
 		),
 		http.Filter(handlers.CORS(
@@ -57,7 +61,11 @@ func NewWhiteListMatcher() selector.MatchFunc {
 	whiteList["/api.user.v1.User/CardStatusHandleTwo"] = struct{}{}
 	whiteList["/api.user.v1.User/Deposit"] = struct{}{}
 	whiteList["/api.user.v1.User/AdminWithdrawEth"] = struct{}{}
+	whiteList["/api.user.v1.User/AdminWithdrawReceipt"] = struct{}{}
 	whiteList["/api.user.v1.User/RewardCardTwo"] = struct{}{}
+	whiteList["/api.user.v1.User/LoginNonce"] = struct{}{}
+	whiteList["/api.user.v1.User/Login"] = struct{}{}
+	whiteList["/api.user.v1.User/Register"] = struct{}{}
 	return func(ctx context.Context, operation string) bool {
 		if _, ok := whiteList[operation]; ok {
 			return false
@@ -65,3 +73,40 @@ func NewWhiteListMatcher() selector.MatchFunc {
 		return true
 	}
 }
+
+// UserTypeCheck 用户端接口只接受用户token，其余接口只接受后台token
+func UserTypeCheck() middleware.Middleware {
+	userOperations := make(map[string]struct{})
+	userOperations["/api.user.v1.User/Transfer"] = struct{}{}
+	userOperations["/api.user.v1.User/Withdraw"] = struct{}{}
+	userOperations["/api.user.v1.User/WithdrawList"] = struct{}{}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, errors.Unauthorized("INVALID_TOKEN", "token错误")
+			}
+
+			claims, ok := jwt.FromContext(ctx)
+			if !ok {
+				return nil, errors.Unauthorized("INVALID_TOKEN", "token错误")
+			}
+
+			c, ok := claims.(jwt2.MapClaims)
+			if !ok {
+				return nil, errors.Unauthorized("INVALID_TOKEN", "token错误")
+			}
+
+			userType := "admin"
+			if _, ok = userOperations[tr.Operation()]; ok {
+				userType = "user"
+			}
+
+			if tmp, _ := c["UserType"].(string); userType != tmp {
+				return nil, errors.Forbidden("FORBIDDEN", "无权限")
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
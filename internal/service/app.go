@@ -0,0 +1,172 @@
+package service
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt2 "github.com/golang-jwt/jwt/v5"
+)
+
+// userIdFromContext 用户端接口从token取用户id，后台token不能调用
+func userIdFromContext(ctx context.Context) (uint64, error) {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return 0, errors.Unauthorized("INVALID_TOKEN", "token错误")
+	}
+
+	c, ok := claims.(jwt2.MapClaims)
+	if !ok {
+		return 0, errors.Unauthorized("INVALID_TOKEN", "token错误")
+	}
+
+	if userType, _ := c["UserType"].(string); "user" != userType {
+		return 0, errors.Unauthorized("INVALID_TOKEN", "token错误")
+	}
+
+	userId, _ := c["UserId"].(float64)
+	if 0 >= userId {
+		return 0, errors.Unauthorized("INVALID_TOKEN", "token错误")
+	}
+
+	return uint64(userId), nil
+}
+
+func (u *UserService) Transfer(ctx context.Context, req *pb.TransferRequest) (*pb.TransferReply, error) {
+	userId, err := userIdFromContext(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == req.SendBody {
+		return nil, errors.New(500, "TRANSFER_ERROR", "参数错误")
+	}
+
+	amount, err := money.Parse(req.SendBody.Amount)
+	if nil != err {
+		return nil, errors.New(500, "TRANSFER_ERROR", "划转金额错误")
+	}
+
+	return u.uuc.Transfer(ctx, userId, req.SendBody.Address, amount)
+}
+
+func (u *UserService) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*pb.WithdrawReply, error) {
+	userId, err := userIdFromContext(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	if nil == req.SendBody {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "参数错误")
+	}
+
+	chain, ok := u.chains.Get(req.SendBody.Chain)
+	if !ok || "" == chain.Token {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现链错误")
+	}
+
+	// 地址校验，不能提到零地址和代币合约
+	if !common.IsHexAddress(req.SendBody.Address) {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现地址错误")
+	}
+	address := common.HexToAddress(req.SendBody.Address)
+	if (common.Address{}) == address || common.HexToAddress(chain.Token) == address {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现地址错误")
+	}
+
+	amount, err := money.Parse(req.SendBody.Amount)
+	if nil != err {
+		return nil, errors.New(500, "WITHDRAW_ERROR", "提现金额错误")
+	}
+
+	return u.uuc.Withdraw(ctx, userId, amount, address.Hex(), chain.Name)
+}
+
+func (u *UserService) WithdrawList(ctx context.Context, req *pb.WithdrawListRequest) (*pb.WithdrawListReply, error) {
+	userId, err := userIdFromContext(ctx)
+	if nil != err {
+		return nil, err
+	}
+
+	return u.uuc.WithdrawList(ctx, userId, req)
+}
+
+// verifyPersonalSign 校验 personal_sign（EIP-191）签名是否由address签出
+func verifyPersonalSign(address common.Address, message string, sign string) bool {
+	sig, err := hexutil.Decode(sign)
+	if nil != err || 65 != len(sig) {
+		return false
+	}
+
+	// 钱包返回的v为27/28
+	if 27 <= sig[64] {
+		sig[64] -= 27
+	}
+
+	pub, err := crypto.SigToPub(accounts.TextHash([]byte(message)), sig)
+	if nil != err {
+		return false
+	}
+
+	return crypto.PubkeyToAddress(*pub) == address
+}
+
+func (u *UserService) LoginNonce(ctx context.Context, req *pb.LoginNonceRequest) (*pb.LoginNonceReply, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, errors.New(500, "LOGIN_ERROR", "地址错误")
+	}
+
+	return u.uuc.LoginNonce(ctx, common.HexToAddress(req.Address).Hex())
+}
+
+func (u *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginReply, error) {
+	if nil == req.SendBody || !common.IsHexAddress(req.SendBody.Address) {
+		return nil, errors.New(500, "LOGIN_ERROR", "地址错误")
+	}
+	address := common.HexToAddress(req.SendBody.Address)
+
+	message, nonce, err := u.uuc.LoginMessage(ctx, address.Hex())
+	if nil != err {
+		return nil, err
+	}
+
+	if !verifyPersonalSign(address, message, req.SendBody.Sign) {
+		return nil, errors.New(500, "LOGIN_ERROR", "签名错误")
+	}
+
+	// 验签通过才作废nonce，错误签名不会把别人的nonce消耗掉
+	err = u.uuc.ConsumeLoginNonce(ctx, address.Hex(), nonce)
+	if nil != err {
+		return nil, err
+	}
+
+	return u.uuc.UserLogin(ctx, address.Hex(), u.ca.JwtKey)
+}
+
+func (u *UserService) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterReply, error) {
+	if nil == req.SendBody || !common.IsHexAddress(req.SendBody.Address) {
+		return nil, errors.New(500, "REGISTER_ERROR", "地址错误")
+	}
+	address := common.HexToAddress(req.SendBody.Address)
+
+	message, nonce, err := u.uuc.LoginMessage(ctx, address.Hex())
+	if nil != err {
+		return nil, err
+	}
+
+	if !verifyPersonalSign(address, message, req.SendBody.Sign) {
+		return nil, errors.New(500, "REGISTER_ERROR", "签名错误")
+	}
+
+	err = u.uuc.ConsumeLoginNonce(ctx, address.Hex(), nonce)
+	if nil != err {
+		return nil, err
+	}
+
+	return u.uuc.Register(ctx, address.Hex(), req.SendBody.Inviter, u.ca.JwtKey)
+}
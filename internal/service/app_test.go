@@ -0,0 +1,168 @@
+package service
+
+import (
+	pb "cardbinance/api/user/v1"
+	"cardbinance/internal/biz"
+	"cardbinance/internal/conf"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+	"testing"
+)
+
+func mustGenerateKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	return key
+}
+
+// personalSign 和钱包personal_sign一致，v为0/1
+func personalSign(t *testing.T, key *ecdsa.PrivateKey, message string) []byte {
+	t.Helper()
+	sig, err := crypto.Sign(accounts.TextHash([]byte(message)), key)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	return sig
+}
+
+func TestVerifyPersonalSign(t *testing.T) {
+	key := mustGenerateKey(t)
+	other := mustGenerateKey(t)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	// v=0和v=1都要覆盖，换消息签到两种都出现
+	sigs := make(map[byte]string, 2)
+	messages := make(map[byte]string, 2)
+	for i := 0; 2 > len(sigs) && i < 1000; i++ {
+		message := fmt.Sprintf("Nonce: %d", i)
+		sig := personalSign(t, key, message)
+		if _, ok := sigs[sig[64]]; !ok {
+			sigs[sig[64]] = hexutil.Encode(sig)
+			messages[sig[64]] = message
+		}
+	}
+	if 2 != len(sigs) {
+		t.Fatalf("recovery id not covered: %v", sigs)
+	}
+
+	withV := func(sign string, v byte) string {
+		sig, _ := hexutil.Decode(sign)
+		sig[64] = v
+		return hexutil.Encode(sig)
+	}
+
+	message := messages[0]
+	tests := []struct {
+		name    string
+		message string
+		sign    string
+		want    bool
+	}{
+		{name: "v=0", message: messages[0], sign: sigs[0], want: true},
+		{name: "v=1", message: messages[1], sign: sigs[1], want: true},
+		{name: "v=27", message: messages[0], sign: withV(sigs[0], 27), want: true},
+		{name: "v=28", message: messages[1], sign: withV(sigs[1], 28), want: true},
+		{name: "v错位", message: messages[0], sign: withV(sigs[0], 28), want: false},
+		{name: "v超范围", message: messages[0], sign: withV(sigs[0], 29), want: false},
+		{name: "其他地址签名", message: message, sign: hexutil.Encode(personalSign(t, other, message)), want: false},
+		{name: "消息不同", message: message + "1", sign: sigs[0], want: false},
+		{name: "空", message: message, sign: "", want: false},
+		{name: "只有0x", message: message, sign: "0x", want: false},
+		{name: "不是hex", message: message, sign: "0xzz", want: false},
+		{name: "缺0x", message: message, sign: sigs[0][2:], want: false},
+		{name: "长度不足", message: message, sign: sigs[0][:len(sigs[0])-2], want: false},
+		{name: "长度过长", message: message, sign: sigs[0] + "00", want: false},
+		{name: "全0", message: message, sign: hexutil.Encode(make([]byte, 65)), want: false},
+	}
+
+	for _, tt := range tests {
+		if got := verifyPersonalSign(address, tt.message, tt.sign); got != tt.want {
+			t.Errorf("%s: verifyPersonalSign = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+// fakeLoginRepo 只实现登录用到的方法，其余方法调用会panic
+type fakeLoginRepo struct {
+	biz.UserRepo
+	nonces map[string]string
+	users  map[string]*biz.User
+}
+
+func (r *fakeLoginRepo) GetWalletNonce(ctx context.Context, wallet string) (string, error) {
+	return r.nonces[wallet], nil
+}
+
+func (r *fakeLoginRepo) DeleteWalletNonce(ctx context.Context, wallet string, nonce string) (bool, error) {
+	if "" == nonce || r.nonces[wallet] != nonce {
+		return false, nil
+	}
+
+	delete(r.nonces, wallet)
+	return true, nil
+}
+
+func (r *fakeLoginRepo) GetUserByAddress(address string) (*biz.User, error) {
+	return r.users[address], nil
+}
+
+func TestLoginNonce(t *testing.T) {
+	key := mustGenerateKey(t)
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+
+	repo := &fakeLoginRepo{
+		nonces: map[string]string{address: "123456"},
+		users:  map[string]*biz.User{address: {ID: 1, Address: address}},
+	}
+	u := &UserService{
+		uuc: biz.NewUserUseCase(repo, nil, log.DefaultLogger),
+		ca:  &conf.Auth{JwtKey: "test"},
+	}
+
+	message, _, err := u.uuc.LoginMessage(context.Background(), address)
+	if err != nil {
+		t.Fatalf("LoginMessage: %v", err)
+	}
+	sign := hexutil.Encode(personalSign(t, key, message))
+	login := func(sign string) error {
+		_, err := u.Login(context.Background(), &pb.LoginRequest{SendBody: &pb.LoginRequest_SendBody{Address: address, Sign: sign}})
+		return err
+	}
+
+	// 错误签名不消耗nonce
+	if err = login(hexutil.Encode(personalSign(t, mustGenerateKey(t), message))); nil == err {
+		t.Fatalf("Login with wrong signer succeeded")
+	}
+	if "123456" != repo.nonces[address] {
+		t.Fatalf("wrong signature consumed nonce")
+	}
+
+	if err = login(sign); nil != err {
+		t.Fatalf("Login: %v", err)
+	}
+	if _, ok := repo.nonces[address]; ok {
+		t.Fatalf("nonce not consumed after login")
+	}
+
+	// 同一签名重放
+	if err = login(sign); nil == err {
+		t.Fatalf("replayed login succeeded")
+	}
+
+	// 新nonce下旧签名无效
+	repo.nonces[address] = "654321"
+	if err = login(sign); nil == err {
+		t.Fatalf("old signature accepted with new nonce")
+	}
+	if "654321" != repo.nonces[address] {
+		t.Fatalf("old signature consumed new nonce")
+	}
+}
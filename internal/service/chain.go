@@ -0,0 +1,89 @@
+package service
+
+import (
+	"cardbinance/internal/conf"
+	"errors"
+	"fmt"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Chain 链配置，充值合约和提现币种
+type Chain struct {
+	Name            string
+	ChainId         int64
+	DepositContract string
+	Token           string
+	Pool            *RpcPool
+}
+
+// Chains 配置的所有链，第一条为默认链
+type Chains struct {
+	list   []*Chain
+	byName map[string]*Chain
+}
+
+func NewChains(cs []*conf.Chain, logger log.Logger) (*Chains, func(), error) {
+	if 0 >= len(cs) {
+		return nil, nil, errors.New("chains not configured")
+	}
+
+	var cleanups []func()
+	cleanup := func() {
+		for _, f := range cleanups {
+			f()
+		}
+	}
+
+	res := &Chains{
+		list:   make([]*Chain, 0, len(cs)),
+		byName: make(map[string]*Chain, len(cs)),
+	}
+	for _, c := range cs {
+		if "" == c.Name || 0 >= c.ChainId {
+			cleanup()
+			return nil, nil, fmt.Errorf("chain config invalid: %v", c)
+		}
+
+		if _, ok := res.byName[c.Name]; ok {
+			cleanup()
+			return nil, nil, fmt.Errorf("chain %s duplicated", c.Name)
+		}
+
+		pool, poolCleanup, err := NewRpcPool(c.Rpc, c.ChainId, logger)
+		if err != nil {
+			cleanup()
+			return nil, nil, fmt.Errorf("chain %s: %w", c.Name, err)
+		}
+		cleanups = append(cleanups, poolCleanup)
+
+		chain := &Chain{
+			Name:            c.Name,
+			ChainId:         c.ChainId,
+			DepositContract: c.DepositContract,
+			Token:           c.Token,
+			Pool:            pool,
+		}
+		res.list = append(res.list, chain)
+		res.byName[chain.Name] = chain
+	}
+
+	return res, cleanup, nil
+}
+
+// Get 按名称取链，名称为空取默认链
+func (c *Chains) Get(name string) (*Chain, bool) {
+	if "" == name {
+		return c.list[0], true
+	}
+
+	chain, ok := c.byName[name]
+	return chain, ok
+}
+
+func (c *Chains) Default() *Chain {
+	return c.list[0]
+}
+
+func (c *Chains) List() []*Chain {
+	return c.list
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"cardbinance/internal/biz"
+	"cardbinance/internal/pkg/money"
+	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"math/big"
+)
+
+// hotWallet 热钱包在某条链上的余额和暂停状态
+type hotWallet struct {
+	Chain   string
+	Address string
+	Token   money.Money
+	Gas     money.Money
+	Paused  bool
+	Reason  string
+}
+
+// getHotWallet 查询热钱包代币和gas余额，低于阈值或后台暂停则不发放
+func (u *UserService) getHotWallet(ctx context.Context, chain *Chain, c *biz.HotWalletConfig) *hotWallet {
+	res := &hotWallet{Chain: chain.Name}
+	if nil == u.signer {
+		res.Paused = true
+		res.Reason = ErrSignerNotConfigured.Error()
+		return res
+	}
+
+	address := u.signer.Address()
+	res.Address = address.Hex()
+
+	if "" == chain.Token {
+		res.Paused = true
+		res.Reason = "提现币种未配置"
+		return res
+	}
+
+	decimals, err := getTokenDecimals(ctx, chain)
+	if nil != err {
+		res.Paused = true
+		res.Reason = "代币精度查询失败：" + err.Error()
+		return res
+	}
+
+	var tokenBalance, gasBalance *big.Int
+	err = chain.Pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+		token, err := NewDfil(common.HexToAddress(chain.Token), client)
+		if err != nil {
+			return err
+		}
+
+		tokenBalance, err = token.BalanceOf(&bind.CallOpts{Context: ctx}, address)
+		if err != nil {
+			return err
+		}
+
+		gasBalance, err = client.BalanceAt(ctx, address, nil)
+		return err
+	})
+	if nil != err {
+		res.Paused = true
+		res.Reason = "余额查询失败：" + err.Error()
+		return res
+	}
+
+	res.Token, _ = biz.TokenToBalance(tokenBalance, decimals)
+	res.Gas, _ = biz.TokenToBalance(gasBalance, 18)
+	res.check(c)
+
+	return res
+}
+
+// check 低于阈值或后台暂停则暂停发放
+func (w *hotWallet) check(c *biz.HotWalletConfig) {
+	if w.Paused {
+		return
+	}
+
+	if c.Paused {
+		w.Paused = true
+		w.Reason = "后台暂停提现"
+	} else if w.Token.LessThan(c.MinToken) {
+		w.Paused = true
+		w.Reason = "热钱包代币余额不足"
+	} else if w.Gas.LessThan(c.MinGas) {
+		w.Paused = true
+		w.Reason = "热钱包gas余额不足"
+	}
+}
+
+// spend 同一批内余额只查一次，每签一笔扣掉出金和预估gas（gas上限*价格）
+func (w *hotWallet) spend(amount money.Money, tx *types.Transaction, c *biz.HotWalletConfig) {
+	gasCost, _ := biz.TokenToBalance(new(big.Int).Sub(tx.Cost(), tx.Value()), 18)
+	w.Token = w.Token.Sub(amount)
+	w.Gas = w.Gas.Sub(gasCost)
+	w.check(c)
+}
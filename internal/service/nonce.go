@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"sync"
+)
+
+// nonceManager 本地分配热钱包nonce，连续发送时不依赖节点的pending状态
+type nonceManager struct {
+	mu     sync.Mutex
+	nonces map[string]uint64
+}
+
+func newNonceManager() *nonceManager {
+	return &nonceManager{nonces: make(map[string]uint64)}
+}
+
+func nonceKey(chain *Chain, address common.Address) string {
+	return chain.Name + ":" + address.Hex()
+}
+
+// Next 取下一个nonce，取本地和链上pending的较大值，外部发过交易也不会冲突
+func (n *nonceManager) Next(ctx context.Context, chain *Chain, address common.Address) (uint64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var pending uint64
+	err := chain.Pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		pending, err = client.PendingNonceAt(ctx, address)
+		return err
+	})
+	if nil != err {
+		return 0, err
+	}
+
+	key := nonceKey(chain, address)
+	nonce := pending
+	if local, ok := n.nonces[key]; ok && local > nonce {
+		nonce = local
+	}
+	n.nonces[key] = nonce + 1
+
+	return nonce, nil
+}
+
+// Release 分配后未使用（未签名落库），退回给下一笔；只退最后分配的，避免重复
+func (n *nonceManager) Release(chain *Chain, address common.Address, nonce uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	key := nonceKey(chain, address)
+	if local, ok := n.nonces[key]; ok && local == nonce+1 {
+		n.nonces[key] = nonce
+	}
+}
+
+// Reset 有交易作废（发不出去或人工重签）时丢弃本地计数，下次按链上pending重新分配，不留nonce空洞
+func (n *nonceManager) Reset(chain *Chain, address common.Address) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.nonces, nonceKey(chain, address))
+}
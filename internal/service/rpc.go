@@ -0,0 +1,298 @@
+package service
+
+import (
+	"cardbinance/internal/conf"
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-kratos/kratos/v2/log"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrNoRpcEndpoint = errors.New("no available rpc endpoint")
+
+// rpcNode 节点，连接复用，连续失败达到阈值后熔断一段时间；chainId 核对通过前不使用
+type rpcNode struct {
+	url       string
+	client    *ethclient.Client
+	latency   time.Duration
+	fails     int32
+	openUntil time.Time
+	verified  bool
+	wrong     bool
+	// ws/ipc没有单次请求超时，只能给整个回调加超时
+	callTimeout bool
+}
+
+// RpcPool 节点池，按延迟选择节点
+type RpcPool struct {
+	mu            sync.RWMutex
+	chainId       int64
+	nodes         []*rpcNode
+	timeout       time.Duration
+	interval      time.Duration
+	failThreshold int32
+	breakDuration time.Duration
+	stop          chan struct{}
+	log           *log.Helper
+}
+
+func NewRpcPool(c *conf.Rpc, chainId int64, logger log.Logger) (*RpcPool, func(), error) {
+	if nil == c || 0 >= len(c.Endpoints) {
+		return nil, nil, errors.New("rpc endpoints not configured")
+	}
+
+	p := &RpcPool{
+		chainId:       chainId,
+		timeout:       5 * time.Second,
+		interval:      30 * time.Second,
+		failThreshold: 3,
+		breakDuration: 60 * time.Second,
+		stop:          make(chan struct{}),
+		log:           log.NewHelper(logger),
+	}
+	if nil != c.Timeout && 0 < c.Timeout.AsDuration() {
+		p.timeout = c.Timeout.AsDuration()
+	}
+	if nil != c.HealthInterval && 0 < c.HealthInterval.AsDuration() {
+		p.interval = c.HealthInterval.AsDuration()
+	}
+	if 0 < c.FailThreshold {
+		p.failThreshold = c.FailThreshold
+	}
+	if nil != c.BreakDuration && 0 < c.BreakDuration.AsDuration() {
+		p.breakDuration = c.BreakDuration.AsDuration()
+	}
+
+	for _, url := range c.Endpoints {
+		client, err := p.dial(url)
+		if err != nil {
+			p.log.Errorf("rpc dial %s: %v", url, err)
+			continue
+		}
+
+		p.nodes = append(p.nodes, &rpcNode{url: url, client: client, callTimeout: !isHttpUrl(url)})
+	}
+
+	if 0 >= len(p.nodes) {
+		return nil, nil, ErrNoRpcEndpoint
+	}
+
+	p.healthCheck()
+	go p.run()
+
+	cleanup := func() {
+		close(p.stop)
+		for _, node := range p.nodes {
+			node.client.Close()
+		}
+	}
+
+	return p, cleanup, nil
+}
+
+// dial http节点每个请求单独超时，一次回调里的多次调用互不占用
+func (p *RpcPool) dial(url string) (*ethclient.Client, error) {
+	if isHttpUrl(url) {
+		client, err := rpc.DialHTTPWithClient(url, &http.Client{Timeout: p.timeout})
+		if err != nil {
+			return nil, err
+		}
+
+		return ethclient.NewClient(client), nil
+	}
+
+	return ethclient.Dial(url)
+}
+
+func isHttpUrl(url string) bool {
+	return strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://")
+}
+
+func (p *RpcPool) run() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.healthCheck()
+		}
+	}
+}
+
+// healthCheck 查询最新区块，记录延迟；未核对的节点先核对chainId，不一致的永久剔除
+func (p *RpcPool) healthCheck() {
+	var wg sync.WaitGroup
+	for _, node := range p.nodes {
+		wg.Add(1)
+		go func(node *rpcNode) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+			defer cancel()
+
+			if !p.checkChainId(ctx, node) {
+				return
+			}
+
+			start := time.Now()
+			_, err := node.client.BlockNumber(ctx)
+			p.report(node, err, time.Since(start))
+		}(node)
+	}
+	wg.Wait()
+}
+
+// checkChainId 返回节点是否可用
+func (p *RpcPool) checkChainId(ctx context.Context, node *rpcNode) bool {
+	p.mu.RLock()
+	verified, wrong := node.verified, node.wrong
+	p.mu.RUnlock()
+	if wrong {
+		return false
+	}
+	if verified {
+		return true
+	}
+
+	chainId, err := node.client.ChainID(ctx)
+	if err != nil {
+		p.report(node, err, 0)
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if chainId.Cmp(big.NewInt(p.chainId)) != 0 {
+		node.wrong = true
+		p.log.Errorf("rpc %s chain id %s, want %d, disabled", node.url, chainId, p.chainId)
+		return false
+	}
+
+	node.verified = true
+	return true
+}
+
+func (p *RpcPool) report(node *rpcNode, err error, latency time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if nil == err {
+		node.fails = 0
+		node.openUntil = time.Time{}
+		if 0 < latency {
+			node.latency = latency
+		}
+		return
+	}
+
+	node.fails++
+	if node.fails >= p.failThreshold {
+		node.openUntil = time.Now().Add(p.breakDuration)
+		p.log.Errorf("rpc %s circuit open, fails %d: %v", node.url, node.fails, err)
+	}
+}
+
+// available 未熔断的节点按延迟排序，全部熔断时按恢复时间排序兜底
+func (p *RpcPool) available() []*rpcNode {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	now := time.Now()
+	res := make([]*rpcNode, 0, len(p.nodes))
+	verified := make([]*rpcNode, 0, len(p.nodes))
+	for _, node := range p.nodes {
+		if !node.verified {
+			continue
+		}
+		verified = append(verified, node)
+
+		if node.openUntil.After(now) {
+			continue
+		}
+		res = append(res, node)
+	}
+
+	if 0 < len(res) {
+		sort.SliceStable(res, func(i, j int) bool {
+			return res[i].latency < res[j].latency
+		})
+		return res
+	}
+
+	res = append(res, verified...)
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].openUntil.Before(res[j].openUntil)
+	})
+	return res
+}
+
+// isTransportError 只有超时、连接错误和节点5xx/429计入熔断；合约回滚、nonce错误等节点正常返回的错误不算
+func isTransportError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return 500 <= httpErr.StatusCode || 429 == httpErr.StatusCode
+	}
+
+	return false
+}
+
+// Do 按顺序在可用节点上执行，http节点超时按单次请求计算（见dial），回调整体只受调用方ctx限制；
+// 传输错误换下一个节点，业务错误直接返回。回调里只放RPC调用，签名等慢操作放在外面
+func (p *RpcPool) Do(ctx context.Context, fn func(ctx context.Context, client *ethclient.Client) error) error {
+	var lastErr error = ErrNoRpcEndpoint
+	for _, node := range p.available() {
+		if nil != ctx.Err() {
+			return ctx.Err()
+		}
+
+		callCtx, cancel := ctx, context.CancelFunc(func() {})
+		if node.callTimeout {
+			callCtx, cancel = context.WithTimeout(ctx, p.timeout)
+		}
+		err := fn(callCtx, node.client)
+		cancel()
+
+		if nil == err {
+			p.report(node, nil, 0)
+			return nil
+		}
+
+		// 调用方取消不算节点的问题
+		if nil != ctx.Err() {
+			return err
+		}
+
+		if !isTransportError(err) {
+			p.report(node, nil, 0)
+			return err
+		}
+
+		p.report(node, err, 0)
+		p.log.Warnf("rpc %s: %v", node.url, err)
+		lastErr = err
+	}
+
+	return lastErr
+}
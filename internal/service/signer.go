@@ -0,0 +1,231 @@
+package service
+
+import (
+	"bytes"
+	"cardbinance/internal/conf"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-kratos/kratos/v2/log"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+var ErrSignerNotConfigured = errors.New("signer not configured")
+
+// Signer 热钱包签名，私钥不出实现，不打印
+type Signer interface {
+	Address() common.Address
+	SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error)
+}
+
+// NewSigner 未配置时返回nil，提现任务不执行；
+// 密钥加载失败只记录错误并关闭提现，不影响服务启动
+func NewSigner(c *conf.Signer, logger log.Logger) (Signer, error) {
+	if nil == c || "" == c.Type {
+		return nil, nil
+	}
+
+	var (
+		signer Signer
+		err    error
+	)
+	switch c.Type {
+	case "keystore":
+		signer, err = newKeystoreSigner(c.KeystorePath, c.PasswordEnv)
+	case "env":
+		signer, err = newEnvSigner(c.PrivateKeyEnv)
+	case "remote":
+		timeout := 10 * time.Second
+		if nil != c.Timeout && 0 < c.Timeout.AsDuration() {
+			timeout = c.Timeout.AsDuration()
+		}
+		signer, err = newRemoteSigner(c.RemoteUrl, c.Address, timeout)
+	default:
+		return nil, fmt.Errorf("signer type %s not supported", c.Type)
+	}
+	if nil != err {
+		log.NewHelper(logger).Errorf("signer disabled, withdrawals will not be sent: %v", err)
+		return nil, nil
+	}
+
+	return signer, nil
+}
+
+// keySigner 本地私钥签名
+type keySigner struct {
+	address    common.Address
+	privateKey *ecdsa.PrivateKey
+}
+
+func newKeystoreSigner(path string, passwordEnv string) (Signer, error) {
+	if "" == path || "" == passwordEnv {
+		return nil, errors.New("keystore signer: keystore_path and password_env required")
+	}
+
+	keyJson, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("keystore signer: read keystore: %w", err)
+	}
+
+	key, err := keystore.DecryptKey(keyJson, os.Getenv(passwordEnv))
+	if err != nil {
+		return nil, fmt.Errorf("keystore signer: decrypt keystore: %w", err)
+	}
+
+	return &keySigner{address: key.Address, privateKey: key.PrivateKey}, nil
+}
+
+func newEnvSigner(privateKeyEnv string) (Signer, error) {
+	if "" == privateKeyEnv {
+		return nil, errors.New("env signer: private_key_env required")
+	}
+
+	hexKey := strings.TrimPrefix(strings.TrimSpace(os.Getenv(privateKeyEnv)), "0x")
+	if "" == hexKey {
+		return nil, fmt.Errorf("env signer: %s is empty", privateKeyEnv)
+	}
+
+	privateKey, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		// 不带出原始错误，避免私钥片段进日志
+		return nil, fmt.Errorf("env signer: %s is not a valid private key", privateKeyEnv)
+	}
+
+	return &keySigner{address: crypto.PubkeyToAddress(privateKey.PublicKey), privateKey: privateKey}, nil
+}
+
+func (s *keySigner) Address() common.Address {
+	return s.address
+}
+
+func (s *keySigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainId), s.privateKey)
+}
+
+// remoteSigner 远程签名服务，eth_signTransaction
+type remoteSigner struct {
+	url     string
+	address common.Address
+	client  *http.Client
+}
+
+func newRemoteSigner(url string, address string, timeout time.Duration) (Signer, error) {
+	if "" == url || !common.IsHexAddress(address) {
+		return nil, errors.New("remote signer: remote_url and address required")
+	}
+
+	return &remoteSigner{
+		url:     url,
+		address: common.HexToAddress(address),
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (s *remoteSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *remoteSigner) SignTx(ctx context.Context, tx *types.Transaction, chainId *big.Int) (*types.Transaction, error) {
+	args := map[string]interface{}{
+		"from":    s.address,
+		"gas":     hexutil.Uint64(tx.Gas()),
+		"value":   (*hexutil.Big)(tx.Value()),
+		"nonce":   hexutil.Uint64(tx.Nonce()),
+		"data":    hexutil.Bytes(tx.Data()),
+		"chainId": (*hexutil.Big)(chainId),
+	}
+	if nil != tx.To() {
+		args["to"] = tx.To()
+	}
+	if types.DynamicFeeTxType == tx.Type() {
+		args["maxFeePerGas"] = (*hexutil.Big)(tx.GasFeeCap())
+		args["maxPriorityFeePerGas"] = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args["gasPrice"] = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "eth_signTransaction",
+		"params":  []interface{}{args},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err = json.Unmarshal(respBody, &res); err != nil {
+		return nil, fmt.Errorf("remote signer: %w", err)
+	}
+	if nil != res.Error {
+		return nil, fmt.Errorf("remote signer: %d %s", res.Error.Code, res.Error.Message)
+	}
+
+	// 返回原始交易，或 {raw, tx}
+	var raw hexutil.Bytes
+	if err = json.Unmarshal(res.Result, &raw); err != nil {
+		var result struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err = json.Unmarshal(res.Result, &result); err != nil {
+			return nil, fmt.Errorf("remote signer: %w", err)
+		}
+		raw = result.Raw
+	}
+
+	signed := new(types.Transaction)
+	if err = signed.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("remote signer: %w", err)
+	}
+
+	// 签名结果必须是原交易，且来自配置的账户
+	sender, err := types.Sender(types.LatestSignerForChainID(chainId), signed)
+	if err != nil {
+		return nil, fmt.Errorf("remote signer: %w", err)
+	}
+	if sender != s.address || signed.Nonce() != tx.Nonce() || signed.Gas() != tx.Gas() ||
+		!bytes.Equal(signed.Data(), tx.Data()) || signed.Value().Cmp(tx.Value()) != 0 ||
+		(nil == signed.To()) != (nil == tx.To()) || (nil != tx.To() && *signed.To() != *tx.To()) ||
+		signed.Type() != tx.Type() || signed.GasPrice().Cmp(tx.GasPrice()) != 0 ||
+		signed.GasFeeCap().Cmp(tx.GasFeeCap()) != 0 || signed.GasTipCap().Cmp(tx.GasTipCap()) != 0 {
+		return nil, errors.New("remote signer: signed transaction mismatch")
+	}
+
+	return signed, nil
+}
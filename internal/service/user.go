@@ -4,32 +4,38 @@ import (
 	pb "cardbinance/api/user/v1"
 	"cardbinance/internal/biz"
 	"cardbinance/internal/conf"
+	"cardbinance/internal/pkg/money"
 	"context"
-	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"math/big"
 	"net/http"
-	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 type UserService struct {
 	pb.UnimplementedUserServer
 
-	uuc *biz.UserUseCase
-	log *log.Helper
-	ca  *conf.Auth
+	uuc    *biz.UserUseCase
+	log    *log.Helper
+	ca     *conf.Auth
+	chains *Chains
+	signer Signer
+	nonces *nonceManager
 }
 
-func NewUserService(uuc *biz.UserUseCase, logger log.Logger, ca *conf.Auth) *UserService {
-	return &UserService{uuc: uuc, log: log.NewHelper(logger), ca: ca}
+func NewUserService(uuc *biz.UserUseCase, logger log.Logger, ca *conf.Auth, chains *Chains, signer Signer) *UserService {
+	return &UserService{uuc: uuc, log: log.NewHelper(logger), ca: ca, chains: chains, signer: signer, nonces: newNonceManager()}
 }
 
 func (u *UserService) OpenCardHandle(ctx context.Context, req *pb.OpenCardHandleRequest) (*pb.OpenCardHandleReply, error) {
@@ -142,15 +148,203 @@ func (u *UserService) RewardCardTwo(ctx context.Context, req *pb.RewardCardTwoRe
 	return nil, nil
 }
 
+// depositLock 进程内锁，入账和重算业绩互斥；只适用单实例部署，多实例需保证只有一个实例跑充值任务
+var depositLock sync.Mutex
+
 func (u *UserService) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.DepositReply, error) {
+	depositLock.Lock()
+	defer depositLock.Unlock()
+
 	end := time.Now().UTC().Add(50 * time.Second)
 
+	// 已注册地址认领之前的充值
+	err := u.uuc.ClaimUnknownDeposits(ctx)
+	if nil != err {
+		fmt.Println(err)
+	}
+
+	mode, confirmations := u.uuc.GetDepositConfig()
+	err = u.uuc.CheckDepositMode(mode)
+	if nil != err {
+		fmt.Println(err)
+		return nil, nil
+	}
+
+	if "index" == mode { // 合约下标只支持默认链
+		return u.depositByIndex(ctx, u.chains.Default())
+	}
+
+	for _, chain := range u.chains.List() {
+		if "" == chain.DepositContract || "" == chain.Token {
+			continue
+		}
+
+		u.depositByLog(ctx, chain, confirmations, u.uuc.GetDepositStartBlock(chain.Name), end)
+	}
+
+	return nil, nil
+}
+
+// depositByLog 按usdt转账事件扫块充值，只处理确认数足够的区块
+func (u *UserService) depositByLog(ctx context.Context, chain *Chain, confirmations uint64, startBlock uint64, end time.Time) {
+	checkpointName := "deposit_" + chain.Name
+
+	decimals, err := getTokenDecimals(ctx, chain)
+	if nil != err {
+		fmt.Println("币种精度读取失败：", chain.Name, err)
+		return
+	}
+
+	// 日志查询失败时减半区块范围重试，节点对大范围查询有限制或超时
+	blockRange := depositBlockRange
+	for i := 1; i <= 10; i++ {
+		var (
+			checkpoint  *biz.DepositCheckpoint
+			depositLogs []*userDeposit
+			head        uint64
+			start       uint64
+			err         error
+		)
+
+		now := time.Now().UTC()
+		if end.Before(now) {
+			break
+		}
+
+		checkpoint, err = u.uuc.GetDepositCheckpoint(checkpointName)
+		if nil != err {
+			fmt.Println(err)
+			break
+		}
+
+		err = chain.Pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+			head, err = client.BlockNumber(ctx)
+			return err
+		})
+		if nil != err {
+			fmt.Println(err)
+			break
+		}
+
+		if head <= confirmations {
+			break
+		}
+		safe := head - confirmations
+
+		if nil == checkpoint {
+			start = startBlock
+			if 0 == start {
+				start = safe
+			}
+		} else {
+			// 已扫描区块被替换，说明发生分叉，回退确认数个区块重新扫描，已入账的按hash去重
+			var blockHash string
+			blockHash, err = getBlockHash(ctx, chain.Pool, checkpoint.BlockNumber)
+			if nil != err {
+				fmt.Println(err)
+				break
+			}
+
+			if blockHash != checkpoint.BlockHash {
+				rewind := uint64(0)
+				if checkpoint.BlockNumber > confirmations {
+					rewind = checkpoint.BlockNumber - confirmations
+				}
+				fmt.Println("区块分叉，回退扫描：", checkpoint.BlockNumber, checkpoint.BlockHash, blockHash, rewind)
+
+				blockHash, err = getBlockHash(ctx, chain.Pool, rewind)
+				if nil != err {
+					fmt.Println(err)
+					break
+				}
+
+				err = u.uuc.SetDepositCheckpoint(ctx, checkpointName, rewind, blockHash)
+				if nil != err {
+					fmt.Println(err)
+					break
+				}
+
+				continue
+			}
+
+			start = checkpoint.BlockNumber + 1
+		}
+
+		if start > safe {
+			break
+		}
+
+		to := start + blockRange - 1
+		if to > safe {
+			to = safe
+		}
+
+		depositLogs, err = getDepositLogs(ctx, chain.Pool, chain.ChainId, chain.DepositContract, chain.Token, start, to)
+		if nil != err {
+			fmt.Println(err)
+			if 1 < blockRange && nil == ctx.Err() {
+				blockRange /= 2
+				continue
+			}
+			break
+		}
+
+		for _, vUser := range depositLogs {
+			relAmount, amountTwo := biz.TokenToBalance(vUser.Value, decimals)
+
+			// 充值，用户不存在或金额不足的进入待处理
+			err = u.uuc.DepositNew(ctx, vUser.Address, &biz.EthUserRecord{
+				Hash:       vUser.Hash,
+				Amount:     vUser.Value.String(),
+				AmountTwo:  amountTwo,
+				RelAmount:  relAmount,
+				Chain:      chain.Name,
+				DepositKey: vUser.Key,
+			})
+			if nil != err {
+				fmt.Println(err)
+				break
+			}
+		}
+
+		// 本段有失败的，不推进进度，下次重扫
+		if nil != err {
+			break
+		}
+
+		var blockHash string
+		blockHash, err = getBlockHash(ctx, chain.Pool, to)
+		if nil != err {
+			fmt.Println(err)
+			break
+		}
+
+		err = u.uuc.SetDepositCheckpoint(ctx, checkpointName, to, blockHash)
+		if nil != err {
+			fmt.Println(err)
+			break
+		}
+
+		time.Sleep(5 * time.Second)
+	}
+
+}
+
+// depositByIndex 按合约用户数组下标轮询充值
+func (u *UserService) depositByIndex(ctx context.Context, chain *Chain) (*pb.DepositReply, error) {
+	end := time.Now().UTC().Add(50 * time.Second)
+
+	decimals, err := getTokenDecimals(ctx, chain)
+	if nil != err {
+		fmt.Println("币种精度读取失败：", chain.Name, err)
+		return nil, nil
+	}
+
 	for i := 1; i <= 10; i++ {
 		var (
 			depositUsdtResult []*userDeposit
-			depositUsers      map[string]*biz.User
-			fromAccount       []string
 			userLength        int64
+			block             uint64
 			last              int64
 			err               error
 		)
@@ -168,7 +362,7 @@ func (u *UserService) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.
 
 		// 0x0299e92df88c034F6425e78b6f6A367e84160B45 test
 		// 0x5d4bAA2A7a73dEF7685d036AAE993662B0Ef2f8F rel
-		userLength, err = getUserLength("0x0876D2b69D53Bf6e5710Aa6b46ea3a739596F864")
+		userLength, block, err = getUserLength(ctx, chain.Pool, chain.DepositContract)
 		if nil != err {
 			fmt.Println(err)
 		}
@@ -187,8 +381,9 @@ func (u *UserService) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.
 
 		// 0x0299e92df88c034F6425e78b6f6A367e84160B454 test
 		// 0x5d4bAA2A7a73dEF7685d036AAE993662B0Ef2f8F rel
-		depositUsdtResult, err = getUserInfo(last, userLength-1, "0x0876D2b69D53Bf6e5710Aa6b46ea3a739596F864")
+		depositUsdtResult, err = getUserInfo(ctx, chain.Pool, chain.ChainId, block, last, userLength-1, chain.DepositContract)
 		if nil != err {
+			fmt.Println("充值批次读取失败：", last, userLength-1, err)
 			break
 		}
 
@@ -202,39 +397,27 @@ func (u *UserService) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.
 			break
 		}
 
-		for _, vUser := range depositUsdtResult {
-			fromAccount = append(fromAccount, vUser.Address)
+		// 统计开始，按下标顺序记录，失败的停在这里下次继续
+		for _, vUser := range depositUsdtResult { // 主查usdt
+			relAmount, amountTwo := biz.TokenToBalance(vUser.Value, decimals)
+
+			// 充值，用户不存在或金额不足的进入待处理
+			err = u.uuc.DepositNew(ctx, vUser.Address, &biz.EthUserRecord{ // 两种币的记录
+				Amount:     vUser.Value.String(),
+				AmountTwo:  amountTwo,
+				RelAmount:  relAmount,
+				Last:       vUser.Index + 1,
+				Chain:      chain.Name,
+				DepositKey: vUser.Key,
+			})
+			if nil != err {
+				fmt.Println(err)
+				break
+			}
 		}
 
-		depositUsers, err = u.uuc.GetUserByAddress(fromAccount...)
-		if nil != depositUsers {
-			// 统计开始
-			for _, vUser := range depositUsdtResult { // 主查usdt
-				if _, ok := depositUsers[vUser.Address]; !ok { // 用户不存在
-					continue
-				}
-
-				var (
-					tmpValue int64
-				)
-
-				if 10 <= vUser.Amount {
-					tmpValue = vUser.Amount
-				} else {
-					return &pb.DepositReply{}, nil
-				}
-
-				// 充值
-				err = u.uuc.DepositNew(ctx, depositUsers[vUser.Address].ID, uint64(tmpValue), &biz.EthUserRecord{ // 两种币的记录
-					UserId:    int64(depositUsers[vUser.Address].ID),
-					Amount:    strconv.FormatInt(tmpValue, 10) + "00000000000000000000",
-					AmountTwo: uint64(vUser.Amount),
-					Last:      userLength,
-				}, false)
-				if nil != err {
-					fmt.Println(err)
-				}
-			}
+		if nil != err {
+			break
 		}
 
 		time.Sleep(5 * time.Second)
@@ -243,40 +426,30 @@ func (u *UserService) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.
 	return nil, nil
 }
 
-func FloatTo18DecimalsString(f float64) string {
-	// 最多保留 18 位小数（足够精度）
-	str := strconv.FormatFloat(f, 'f', -1, 64)
-
-	parts := strings.Split(str, ".")
-	integerPart := parts[0]
-	decimalPart := ""
+var withdrawLock sync.Mutex
 
-	if len(parts) > 1 {
-		decimalPart = parts[1]
-	}
-
-	// 计算还需要补多少个0
-	padZeros := 18 - len(decimalPart)
-	if padZeros < 0 {
-		// 多余则截断
-		decimalPart = decimalPart[:18]
-	} else {
-		// 不足则补0
-		decimalPart += strings.Repeat("0", padZeros)
-	}
+// withdrawBroadcastConcurrency 同时广播的交易数
+const withdrawBroadcastConcurrency = 10
 
-	return integerPart + decimalPart
-}
+// withdrawMinSend 低于0.001的提现不上链
+var withdrawMinSend = money.FromWei(big.NewInt(1), 3)
 
 func (u *UserService) AdminWithdrawEth(ctx context.Context, req *pb.AdminWithdrawEthRequest) (*pb.AdminWithdrawEthReply, error) {
+	withdrawLock.Lock()
+	defer withdrawLock.Unlock()
+
 	var (
-		withdraw     *biz.Withdraw
-		userIds      []uint64
-		userIdsMap   map[uint64]uint64
-		users        map[uint64]*biz.User
-		tokenAddress string
-		err          error
+		withdraws []*biz.Withdraw
+		userIds   []uint64
+		users     map[uint64]*biz.User
+		err       error
 	)
+	if nil == u.signer {
+		fmt.Println(ErrSignerNotConfigured)
+		return &pb.AdminWithdrawEthReply{}, nil
+	}
+
+	batchSize := u.uuc.GetWithdrawBatchSize()
 	end := time.Now().UTC().Add(50 * time.Second)
 
 	for j := 1; j <= 10; j++ {
@@ -286,15 +459,19 @@ func (u *UserService) AdminWithdrawEth(ctx context.Context, req *pb.AdminWithdra
 			break
 		}
 
-		withdraw, err = u.uuc.GetWithdrawPassOrRewardedFirst(ctx)
-		if nil == withdraw {
+		// 先重发已签名未广播成功的，有未发出的不再签新交易，避免nonce被占用
+		if 0 < u.resendSignedWithdraws(ctx) {
 			break
 		}
 
-		userIdsMap = make(map[uint64]uint64, 0)
-		userIdsMap[withdraw.UserId] = withdraw.UserId
-		for _, v := range userIdsMap {
-			userIds = append(userIds, v)
+		withdraws, err = u.uuc.GetWithdrawsPassOrRewarded(batchSize)
+		if nil != err || 0 >= len(withdraws) {
+			break
+		}
+
+		userIds = make([]uint64, 0, len(withdraws))
+		for _, withdraw := range withdraws {
+			userIds = append(userIds, withdraw.UserId)
 		}
 
 		users, err = u.uuc.GetUserByUserIds(userIds...)
@@ -302,53 +479,463 @@ func (u *UserService) AdminWithdrawEth(ctx context.Context, req *pb.AdminWithdra
 			return nil, err
 		}
 
+		// 按顺序签名落库，nonce连续；再并发广播，回执由AdminWithdrawReceipt异步确认
+		signed, stopped := u.signWithdraws(ctx, withdraws, users)
+		u.broadcastWithdraws(ctx, signed)
+
+		if stopped || len(withdraws) < batchSize {
+			break
+		}
+	}
+
+	return &pb.AdminWithdrawEthReply{}, nil
+}
+
+// signedWithdraw 已签名落库待广播的提现
+type signedWithdraw struct {
+	withdraw *biz.Withdraw
+	chain    *Chain
+	tx       *types.Transaction
+}
+
+// signWithdraws 逐笔分配nonce并签名落库，余额、出金上限不足时停止，返回是否中途停止
+func (u *UserService) signWithdraws(ctx context.Context, withdraws []*biz.Withdraw, users map[uint64]*biz.User) ([]*signedWithdraw, bool) {
+	var (
+		res = make([]*signedWithdraw, 0, len(withdraws))
+		err error
+	)
+
+	// 每批先查热钱包余额，不足则暂停，不再反复发送失败
+	hotWalletConfig := u.uuc.GetHotWalletConfig()
+	wallets := make(map[string]*hotWallet, 0)
+
+	for _, withdraw := range withdraws {
+		// 按申请时填写的地址出款，用户不存在的跳过
 		if _, ok := users[withdraw.UserId]; !ok {
 			continue
 		}
 
-		tokenAddress = "0x55d398326f99059fF775485246999027B3197955"
-		_, err = u.uuc.UpdateWithdrawDoing(ctx, withdraw.ID)
+		// 地址异常的不出款，避免转到零地址，转人工处理
+		if !common.IsHexAddress(withdraw.Address) || (common.Address{}) == common.HexToAddress(withdraw.Address) {
+			err = u.uuc.WithdrawToReview(ctx, withdraw, "提现地址错误")
+			if nil != err {
+				fmt.Println("提现转人工失败：", err, withdraw.ID, withdraw.Address)
+			}
+			continue
+		}
+
+		chain, ok := u.chains.Get(withdraw.Chain)
+		if !ok || "" == chain.Token {
+			fmt.Println("提现链未配置：", withdraw)
+			continue
+		}
+
+		wallet, ok := wallets[chain.Name]
+		if !ok {
+			wallet = u.getHotWallet(ctx, chain, hotWalletConfig)
+			wallets[chain.Name] = wallet
+		}
+		if wallet.Paused || wallet.Token.LessThan(withdraw.RelAmount) {
+			fmt.Println("提现暂停：", chain.Name, wallet.Reason, wallet.Token, wallet.Gas, withdraw.ID)
+			return res, true
+		}
+
+		// 单笔超过每小时上限的等不到额度，转人工，不堵后面的提现
+		if u.uuc.WithdrawAboveHourlyCap(withdraw.RelAmount) {
+			err = u.uuc.WithdrawToReview(ctx, withdraw, "单笔超过热钱包每小时出金上限")
+			if nil != err {
+				fmt.Println("提现转人工失败：", err, withdraw.ID)
+			}
+			continue
+		}
+
+		// 热钱包每小时出金上限，超过等下一轮，已签名的已计入
+		var overCap bool
+		overCap, err = u.uuc.WithdrawOverHourlyCap(ctx, withdraw.RelAmount)
+		if overCap || nil != err {
+			fmt.Println("提现超过每小时出金上限，暂缓发放：", err, withdraw.ID)
+			return res, true
+		}
+
+		var decimals uint8
+		decimals, err = getTokenDecimals(ctx, chain)
 		if nil != err {
+			fmt.Println("提现代币精度查询失败：", err, withdraw.ID)
+			return res, true
+		}
+
+		// 不足0.001不上链，驳回退回余额
+		withDrawAmount := withdraw.RelAmount.Wei(decimals)
+		if withdraw.RelAmount.LessThan(withdrawMinSend) {
+			err = u.uuc.RejectWithdrawBelowMin(ctx, withdraw)
+			if nil != err {
+				fmt.Println("提现金额过小驳回失败：", err, withdraw.ID)
+			}
 			continue
 		}
 
-		tmpUrl1 := "https://bsc-dataseed4.binance.org/"
-		withDrawAmount := FloatTo18DecimalsString(withdraw.RelAmount)
-		if len(withDrawAmount) <= 15 {
-			fmt.Println(withDrawAmount, withdraw)
-			_, err = u.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
+		err = u.uuc.UpdateWithdrawDoing(ctx, withdraw)
+		if nil != err {
 			continue
 		}
 
-		for i := 0; i <= 5; i++ {
-			//fmt.Println(11111, user.ToAddress, v.Amount, balanceInt)
-			_, err = toToken("", users[withdraw.UserId].Address, withDrawAmount, tokenAddress, tmpUrl1)
-			if err == nil {
-				_, err = u.uuc.UpdateWithdrawSuccess(ctx, withdraw.ID)
-				//time.Sleep(3 * time.Second)
-				break
-			} else {
-				fmt.Println(err)
-				if 0 == i {
-					tmpUrl1 = "https://bsc-dataseed1.binance.org"
-				} else if 1 == i {
-					tmpUrl1 = "https://bsc-dataseed3.binance.org"
-				} else if 2 == i {
-					tmpUrl1 = "https://bsc-dataseed2.binance.org"
-				} else if 3 == i {
-					tmpUrl1 = "https://bnb-bscnews.rpc.blxrbdn.com/"
-				} else if 4 == i {
-					tmpUrl1 = "https://bsc-dataseed.binance.org"
+		var nonce uint64
+		nonce, err = u.nonces.Next(ctx, chain, u.signer.Address())
+		if nil != err {
+			fmt.Println("提现nonce获取失败：", err, withdraw)
+			_ = u.uuc.UpdateWithdrawRetry(ctx, withdraw)
+			return res, true
+		}
+
+		// 只签名不广播，签名交易先落库，重试只重发这一笔
+		var (
+			tx    *types.Transaction
+			rawTx []byte
+		)
+		err = chain.Pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+			tx, err = toToken(ctx, client, u.signer.Address(), nonce, withdraw.Address, withDrawAmount, chain.Token)
+			return err
+		})
+		// 签名不占用节点，远程签名的超时由签名服务配置控制，签名慢不算节点故障
+		if nil == err {
+			tx, err = u.signer.SignTx(ctx, tx, new(big.Int).SetInt64(chain.ChainId))
+		}
+		if nil == err {
+			rawTx, err = tx.MarshalBinary()
+		}
+		if nil != err {
+			// 交易未落库也未广播，退回待发放，nonce留给下一笔
+			u.nonces.Release(chain, u.signer.Address(), nonce)
+			_ = u.uuc.UpdateWithdrawRetry(ctx, withdraw)
+			fmt.Println("提现签名失败：", err, withdraw.ID, withdraw.Address, withDrawAmount, chain.Token, nonce)
+			return res, true
+		}
+
+		err = u.uuc.UpdateWithdrawSigned(ctx, withdraw.ID, tx.Hash().Hex(), nonce, hexutil.Encode(rawTx))
+		if nil != err {
+			// 未广播，nonce可复用
+			u.nonces.Release(chain, u.signer.Address(), nonce)
+			fmt.Println("提现签名交易记录失败：", err, withdraw.ID)
+			return res, true
+		}
+
+		wallet.spend(withdraw.RelAmount, tx, hotWalletConfig)
+		res = append(res, &signedWithdraw{withdraw: withdraw, chain: chain, tx: tx})
+	}
+
+	return res, false
+}
+
+// broadcastWithdraws 并发广播，节点会按nonce排队；失败的留在doing，下一轮重发同一笔
+func (u *UserService) broadcastWithdraws(ctx context.Context, signed []*signedWithdraw) {
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, withdrawBroadcastConcurrency)
+	)
+
+	for _, v := range signed {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(v *signedWithdraw) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := broadcastTx(ctx, v.chain, v.tx)
+			if nil != err {
+				fmt.Println("提现广播失败，等待重发：", err, v.withdraw.ID, v.tx.Hash().Hex())
+				return
+			}
+
+			err = u.uuc.UpdateWithdrawSending(ctx, v.withdraw.ID, v.tx.Hash().Hex(), v.tx.Nonce())
+			if nil != err {
+				fmt.Println("提现广播成功，记录失败：", err, v.withdraw.ID, v.tx.Hash().Hex(), v.tx.Nonce())
+			}
+		}(v)
+	}
+
+	wg.Wait()
+}
+
+// resendSignedWithdraws 重发已签名落库但未确认广播的提现，返回仍未发出的数量
+func (u *UserService) resendSignedWithdraws(ctx context.Context) int {
+	withdraws, err := u.uuc.GetWithdrawsDoing(100)
+	if nil != err {
+		fmt.Println(err)
+		return 0
+	}
+
+	unsent := 0
+	for _, withdraw := range withdraws {
+		if "" == withdraw.RawTx {
+			// 签名落库失败或老数据，是否已出款需人工核对，不堵后面的提现
+			err = u.uuc.WithdrawSendFailed(ctx, withdraw, "发放中没有签名交易，人工核对是否已出款")
+			if nil != err {
+				fmt.Println("提现置为失败失败：", err, withdraw.ID)
+			}
+			continue
+		}
+
+		chain, ok := u.chains.Get(withdraw.Chain)
+		if !ok {
+			fmt.Println("提现链未配置：", withdraw)
+			unsent++
+			continue
+		}
+
+		var tx *types.Transaction
+		tx, err = decodeWithdrawTx(withdraw)
+		if nil != err {
+			fmt.Println("提现签名交易损坏：", err, withdraw.ID)
+			err = u.uuc.WithdrawSendFailed(ctx, withdraw, "签名交易损坏，人工核对是否已出款")
+			if nil != err {
+				fmt.Println("提现置为失败失败：", err, withdraw.ID)
+			}
+			continue
+		}
+
+		err = broadcastTx(ctx, chain, tx)
+		if reason := permanentSendError(err); "" != reason {
+			// 重发也不会成功，置为失败等人工退款或重新签名；本地nonce作废，避免后面的交易卡在空洞后
+			fmt.Println("提现广播失败，置为失败：", err, withdraw.ID, withdraw.TxHash)
+			u.nonces.Reset(chain, u.signer.Address())
+			err = u.uuc.WithdrawSendFailed(ctx, withdraw, "广播失败："+reason)
+			if nil != err {
+				fmt.Println("提现置为失败失败：", err, withdraw.ID)
+			}
+			continue
+		}
+		if nil != err {
+			fmt.Println("提现重发失败：", err, withdraw.ID, withdraw.TxHash)
+			unsent++
+			continue
+		}
+
+		err = u.uuc.UpdateWithdrawSending(ctx, withdraw.ID, withdraw.TxHash, withdraw.Nonce)
+		if nil != err {
+			fmt.Println("提现重发成功，记录失败：", err, withdraw.ID, withdraw.TxHash)
+		}
+	}
+
+	return unsent
+}
+
+// decodeWithdrawTx 解析落库的签名交易，哈希不一致视为损坏
+func decodeWithdrawTx(withdraw *biz.Withdraw) (*types.Transaction, error) {
+	rawTx, err := hexutil.Decode(withdraw.RawTx)
+	if nil != err {
+		return nil, err
+	}
+
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(rawTx); nil != err {
+		return nil, err
+	}
+
+	if tx.Hash().Hex() != withdraw.TxHash {
+		return nil, fmt.Errorf("tx hash %s, want %s", tx.Hash().Hex(), withdraw.TxHash)
+	}
+
+	return tx, nil
+}
+
+// permanentSendErrors 节点拒绝且重发不会成功的错误
+var permanentSendErrors = []string{
+	"nonce too low",
+	"insufficient funds",
+	"intrinsic gas too low",
+	"exceeds block gas limit",
+	"invalid sender",
+}
+
+// permanentSendError 返回匹配的错误，临时错误返回空
+func permanentSendError(err error) string {
+	if nil == err {
+		return ""
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, v := range permanentSendErrors {
+		if strings.Contains(msg, v) {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// broadcastTx 广播同一笔已签名交易，链上已有则视为成功，不会重复出款
+func broadcastTx(ctx context.Context, chain *Chain, tx *types.Transaction) error {
+	return chain.Pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+		_, _, err := client.TransactionByHash(ctx, tx.Hash())
+		if nil == err {
+			return nil
+		}
+		if ethereum.NotFound != err {
+			return err
+		}
+
+		err = client.SendTransaction(ctx, tx)
+		if nil != err && strings.Contains(strings.ToLower(err.Error()), "already known") {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// withdrawResendAfter 已广播超过这个时间未打包的重发同一笔，节点交易池可能已丢弃
+const withdrawResendAfter = 10 * time.Minute
+
+// AdminWithdrawReceipt 查询已广播提现的回执，达到确认数后置为confirmed或failed；
+// 未打包且nonce已被其他交易占用的置为failed，长时间未打包的重发；和发放互斥，nonce重置不和签名交叉
+func (u *UserService) AdminWithdrawReceipt(ctx context.Context, req *pb.AdminWithdrawReceiptRequest) (*pb.AdminWithdrawReceiptReply, error) {
+	withdrawLock.Lock()
+	defer withdrawLock.Unlock()
+
+	var (
+		withdraws []*biz.Withdraw
+		res       = &pb.AdminWithdrawReceiptReply{}
+		err       error
+	)
+
+	confirmations := u.uuc.GetWithdrawConfirmations()
+	withdraws, err = u.uuc.GetWithdrawsSending(100)
+	if nil != err {
+		return nil, err
+	}
+
+	heads := make(map[string]uint64, 0)
+	for _, withdraw := range withdraws {
+		chain, ok := u.chains.Get(withdraw.Chain)
+		if !ok {
+			fmt.Println("提现链未配置：", withdraw)
+			continue
+		}
+
+		if _, ok = heads[chain.Name]; !ok {
+			var head uint64
+			err = chain.Pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+				head, err = client.BlockNumber(ctx)
+				return err
+			})
+			if nil != err {
+				fmt.Println("提现回执区块高度获取失败：", chain.Name, err)
+				continue
+			}
+			heads[chain.Name] = head
+		}
+
+		// 老数据没有签名交易，只查回执
+		var (
+			tx   *types.Transaction
+			from common.Address
+		)
+		if "" != withdraw.RawTx {
+			tx, err = decodeWithdrawTx(withdraw)
+			if nil == err {
+				from, err = types.Sender(types.LatestSignerForChainID(big.NewInt(chain.ChainId)), tx)
+			}
+			if nil != err {
+				fmt.Println("提现签名交易损坏：", err, withdraw.ID)
+				tx = nil
+			}
+		}
+
+		var (
+			receipt   *types.Receipt
+			nonceUsed bool
+		)
+		safe := heads[chain.Name]
+		if safe > confirmations {
+			safe -= confirmations
+		}
+		err = chain.Pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+			// 同一节点先查确认区块的nonce再查回执：nonce已被占用而回执查不到，说明是别的交易用了这个nonce
+			nonceUsed = false
+			if nil != tx {
+				var nonce uint64
+				nonce, err = client.NonceAt(ctx, from, new(big.Int).SetUint64(safe))
+				if nil != err {
+					return err
 				}
-				fmt.Println(33331, err, users[withdraw.UserId].Address, withdraw.Address, withDrawAmount, tokenAddress)
-				time.Sleep(3 * time.Second)
+				nonceUsed = nonce > tx.Nonce()
 			}
+
+			receipt, err = client.TransactionReceipt(ctx, common.HexToHash(withdraw.TxHash))
+			if ethereum.NotFound == err {
+				// 未打包
+				receipt = nil
+				return nil
+			}
+			return err
+		})
+		if nil != err {
+			fmt.Println("提现回执查询失败：", withdraw.ID, withdraw.TxHash, err)
+			continue
 		}
 
-		time.Sleep(5 * time.Second)
+		if nil == receipt || nil == receipt.BlockNumber {
+			if nonceUsed {
+				err = u.uuc.WithdrawSendFailed(ctx, withdraw, "交易未打包，nonce已被其他交易占用，未出款")
+				if nil == err {
+					res.Failed++
+				} else {
+					fmt.Println(err, withdraw.ID)
+				}
+				fmt.Println("提现交易被丢弃：", withdraw.ID, withdraw.TxHash)
+				continue
+			}
+
+			if nil != tx && withdraw.UpdatedAt.Before(time.Now().UTC().Add(-withdrawResendAfter)) {
+				err = broadcastTx(ctx, chain, tx)
+				if reason := permanentSendError(err); "" != reason {
+					fmt.Println("提现重发失败，置为失败：", err, withdraw.ID, withdraw.TxHash)
+					if nil != u.signer {
+						u.nonces.Reset(chain, u.signer.Address())
+					}
+					err = u.uuc.WithdrawSendFailed(ctx, withdraw, "重发失败："+reason)
+					if nil == err {
+						res.Failed++
+					} else {
+						fmt.Println(err, withdraw.ID)
+					}
+					continue
+				}
+				if nil != err {
+					fmt.Println("提现重发失败：", err, withdraw.ID, withdraw.TxHash)
+				}
+			}
+
+			res.Pending++
+			continue
+		}
+
+		blockNumber := receipt.BlockNumber.Uint64()
+		if blockNumber+confirmations > heads[chain.Name] {
+			res.Pending++
+			continue
+		}
+
+		if types.ReceiptStatusSuccessful == receipt.Status {
+			err = u.uuc.UpdateWithdrawConfirmed(ctx, withdraw.ID, blockNumber)
+			if nil == err {
+				res.Confirmed++
+			}
+		} else {
+			err = u.uuc.UpdateWithdrawFailed(ctx, withdraw.ID, blockNumber)
+			if nil == err {
+				res.Failed++
+			}
+			fmt.Println("提现交易失败：", withdraw.ID, withdraw.TxHash)
+		}
+		if nil != err {
+			fmt.Println(err, withdraw.ID)
+		}
 	}
 
-	return &pb.AdminWithdrawEthReply{}, nil
+	return res, nil
 }
 
 func (u *UserService) AdminLogin(ctx context.Context, req *pb.AdminLoginRequest) (*pb.AdminLoginReply, error) {
@@ -383,6 +970,162 @@ func (u *UserService) AdminConfigUpdate(ctx context.Context, req *pb.AdminConfig
 	return u.uuc.AdminConfigUpdate(ctx, req)
 }
 
+// AdminHotWallet 热钱包余额和提现暂停状态
+func (u *UserService) AdminHotWallet(ctx context.Context, req *pb.AdminHotWalletRequest) (*pb.AdminHotWalletReply, error) {
+	hotWalletConfig := u.uuc.GetHotWalletConfig()
+	res := &pb.AdminHotWalletReply{
+		List:     make([]*pb.AdminHotWalletReply_List, 0),
+		Paused:   hotWalletConfig.Paused,
+		MinToken: hotWalletConfig.MinToken.String(),
+		MinGas:   hotWalletConfig.MinGas.String(),
+	}
+
+	for _, chain := range u.chains.List() {
+		wallet := u.getHotWallet(ctx, chain, hotWalletConfig)
+		res.List = append(res.List, &pb.AdminHotWalletReply_List{
+			Chain:   wallet.Chain,
+			Address: wallet.Address,
+			Token:   wallet.Token.String(),
+			Gas:     wallet.Gas.String(),
+			Paused:  wallet.Paused,
+			Reason:  wallet.Reason,
+		})
+	}
+
+	return res, nil
+}
+
+func (u *UserService) AdminReconcile(ctx context.Context, req *pb.AdminReconcileRequest) (*pb.AdminReconcileReply, error) {
+	return u.uuc.AdminReconcile(ctx, req)
+}
+
+func (u *UserService) AdminReconcileList(ctx context.Context, req *pb.AdminReconcileListRequest) (*pb.AdminReconcileListReply, error) {
+	return u.uuc.AdminReconcileList(ctx, req)
+}
+
+func (u *UserService) AdminReconcileEntries(ctx context.Context, req *pb.AdminReconcileEntriesRequest) (*pb.AdminReconcileEntriesReply, error) {
+	return u.uuc.AdminReconcileEntries(ctx, req)
+}
+
+func (u *UserService) AdminLedgerVerify(ctx context.Context, req *pb.AdminLedgerVerifyRequest) (*pb.AdminLedgerVerifyReply, error) {
+	return u.uuc.AdminLedgerVerify(ctx, req)
+}
+
+func (u *UserService) AdminLedgerOpen(ctx context.Context, req *pb.AdminLedgerOpenRequest) (*pb.AdminLedgerOpenReply, error) {
+	return u.uuc.AdminLedgerOpen(ctx, req)
+}
+
+func (u *UserService) AdminWithdrawList(ctx context.Context, req *pb.AdminWithdrawListRequest) (*pb.AdminWithdrawListReply, error) {
+	return u.uuc.AdminWithdrawList(ctx, req)
+}
+
+// AdminWithdrawHandle 置失败、重新签名前先在链上核对未出款，和发放互斥
+func (u *UserService) AdminWithdrawHandle(ctx context.Context, req *pb.AdminWithdrawHandleRequest) (*pb.AdminWithdrawHandleReply, error) {
+	if nil == req.SendBody || ("fail" != req.SendBody.Action && "resign" != req.SendBody.Action) {
+		return u.uuc.AdminWithdrawHandle(ctx, req)
+	}
+
+	withdrawLock.Lock()
+	defer withdrawLock.Unlock()
+
+	withdraw, err := u.uuc.GetWithdrawById(req.SendBody.Id)
+	if nil != err {
+		return &pb.AdminWithdrawHandleReply{}, err
+	}
+	if nil == withdraw {
+		return &pb.AdminWithdrawHandleReply{}, errors.New(500, "WITHDRAW_ERROR", "提现记录不存在")
+	}
+
+	chain, ok := u.chains.Get(withdraw.Chain)
+	if !ok {
+		return &pb.AdminWithdrawHandleReply{}, errors.New(500, "WITHDRAW_ERROR", "提现链未配置")
+	}
+
+	// 没有交易哈希的没有广播过，老数据由人工核对
+	if "" != withdraw.TxHash {
+		err = checkWithdrawUnpaid(ctx, chain, withdraw)
+		if nil != err {
+			return &pb.AdminWithdrawHandleReply{}, err
+		}
+	}
+
+	res, err := u.uuc.AdminWithdrawHandle(ctx, req)
+	if nil != err {
+		return res, err
+	}
+
+	// 作废的nonce不再占用，下一笔按链上pending分配
+	if nil != u.signer {
+		u.nonces.Reset(chain, u.signer.Address())
+	}
+
+	return res, nil
+}
+
+// checkWithdrawUnpaid 交易已成功或还在交易池中的不能置失败、重签
+func checkWithdrawUnpaid(ctx context.Context, chain *Chain, withdraw *biz.Withdraw) error {
+	var (
+		receipt *types.Receipt
+		pending bool
+	)
+	err := chain.Pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+		var err error
+		pending = false
+		receipt, err = client.TransactionReceipt(ctx, common.HexToHash(withdraw.TxHash))
+		if nil == err {
+			return nil
+		}
+		if ethereum.NotFound != err {
+			return err
+		}
+
+		receipt = nil
+		_, _, err = client.TransactionByHash(ctx, common.HexToHash(withdraw.TxHash))
+		if ethereum.NotFound == err {
+			return nil
+		}
+		pending = nil == err
+		return err
+	})
+	if nil != err {
+		return errors.New(500, "WITHDRAW_ERROR", "链上交易查询失败")
+	}
+
+	if nil != receipt && types.ReceiptStatusSuccessful == receipt.Status {
+		return errors.New(500, "WITHDRAW_ERROR", "链上交易已成功，不能置为失败或重新签名")
+	}
+
+	if pending {
+		return errors.New(500, "WITHDRAW_ERROR", "交易仍在节点交易池中，不能置为失败或重新签名")
+	}
+
+	return nil
+}
+
+func (u *UserService) AdminCardOrderList(ctx context.Context, req *pb.AdminCardOrderListRequest) (*pb.AdminCardOrderListReply, error) {
+	return u.uuc.AdminCardOrderList(ctx, req)
+}
+
+func (u *UserService) AdminCardOrderHandle(ctx context.Context, req *pb.AdminCardOrderHandleRequest) (*pb.AdminCardOrderHandleReply, error) {
+	return u.uuc.AdminCardOrderHandle(ctx, req)
+}
+
+func (u *UserService) AdminRecomputeTotalAmount(ctx context.Context, req *pb.AdminRecomputeTotalAmountRequest) (*pb.AdminRecomputeTotalAmountReply, error) {
+	// 重算期间不入账
+	depositLock.Lock()
+	defer depositLock.Unlock()
+
+	return u.uuc.AdminRecomputeTotalAmount(ctx, req)
+}
+
+func (u *UserService) AdminDepositPendingList(ctx context.Context, req *pb.AdminDepositPendingListRequest) (*pb.AdminDepositPendingListReply, error) {
+	return u.uuc.AdminDepositPendingList(ctx, req)
+}
+
+func (u *UserService) AdminDepositPendingHandle(ctx context.Context, req *pb.AdminDepositPendingHandleRequest) (*pb.AdminDepositPendingHandleReply, error) {
+	return u.uuc.AdminDepositPendingHandle(ctx, req)
+}
+
 type CallbackRequest struct {
 	Version   string          `json:"version"`
 	EventName string          `json:"eventName"`
@@ -445,189 +1188,241 @@ func (u *UserService) CallBack(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte(`{"status":"ok"}`))
 }
 
-func getUserLength(address string) (int64, error) {
-	url1 := "https://bsc-dataseed4.binance.org/"
-
-	var balInt int64
-	for i := 0; i < 5; i++ {
-		if 1 == i {
-			url1 = "https://binance.llamarpc.com/"
-		} else if 2 == i {
-			url1 = "https://bscrpc.com/"
-		} else if 3 == i {
-			url1 = "https://bsc-pokt.nodies.app/"
-		} else if 4 == i {
-			url1 = "https://data-seed-prebsc-1-s3.binance.org:8545/"
-		}
-
-		client, err := ethclient.Dial(url1)
+// getUserLength 用户数组长度，同时返回读取时的区块，后续按同一区块读取明细
+func getUserLength(ctx context.Context, pool *RpcPool, address string) (int64, uint64, error) {
+	var (
+		balInt int64
+		block  uint64
+	)
+	err := pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+		tokenAddress := common.HexToAddress(address)
+		instance, err := NewBuySomething(tokenAddress, client)
 		if err != nil {
-			fmt.Println(nil, err)
-			continue
+			return err
 		}
 
-		tokenAddress := common.HexToAddress(address)
-		instance, err := NewBuySomething(tokenAddress, client)
+		block, err = client.BlockNumber(ctx)
 		if err != nil {
-			fmt.Println(nil, err)
-			continue
+			return err
 		}
 
-		bals, err := instance.GetUserLength(&bind.CallOpts{})
+		bals, err := instance.GetUserLength(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)})
 		if err != nil {
-			fmt.Println(err)
-			//url1 = "https://bsc-dataseed4.binance.org"
-			continue
+			return err
 		}
 
 		balInt = bals.Int64()
-		break
+		return nil
+	})
+	if err != nil {
+		fmt.Println(err)
+		return -1, 0, err
 	}
 
-	return balInt, nil
+	return balInt, block, nil
 }
 
+const depositBlockRange = uint64(1000)
+
 type userDeposit struct {
 	Address string
-	Amount  int64
+	Value   *big.Int // 链上最小单位
+	Hash    string
+	Key     string // 唯一键，同一笔充值只入账一次
+	Index   int64  // 合约用户数组下标
 }
 
-func getUserInfo(start int64, end int64, address string) ([]*userDeposit, error) {
-	url1 := "https://bsc-dataseed4.binance.org/"
+var tokenDecimalsCache sync.Map
 
-	var (
-		bals  []common.Address
-		bals2 []*big.Int
-	)
-	users := make([]*userDeposit, 0)
+// getTokenDecimals 币种精度，读取一次后缓存
+func getTokenDecimals(ctx context.Context, chain *Chain) (uint8, error) {
+	key := chain.Name + ":" + chain.Token
+	if v, ok := tokenDecimalsCache.Load(key); ok {
+		return v.(uint8), nil
+	}
 
-	for i := 0; i < 5; i++ {
-		if 1 == i {
-			url1 = "https://binance.llamarpc.com/"
-		} else if 2 == i {
-			url1 = "https://bscrpc.com/"
-		} else if 3 == i {
-			url1 = "https://bsc-pokt.nodies.app/"
-		} else if 4 == i {
-			url1 = "https://data-seed-prebsc-1-s3.binance.org:8545/"
+	var decimals uint8
+	err := chain.Pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+		token, err := NewDfil(common.HexToAddress(chain.Token), client)
+		if err != nil {
+			return err
 		}
 
-		client, err := ethclient.Dial(url1)
+		decimals, err = token.Decimals(&bind.CallOpts{Context: ctx})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	tokenDecimalsCache.Store(key, decimals)
+	return decimals, nil
+}
+
+// getBlockHash 区块hash
+func getBlockHash(ctx context.Context, pool *RpcPool, number uint64) (string, error) {
+	var blockHash string
+	err := pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
 		if err != nil {
-			fmt.Println(nil, err)
-			continue
+			return err
 		}
 
-		tokenAddress := common.HexToAddress(address)
-		instance, err := NewBuySomething(tokenAddress, client)
+		blockHash = header.Hash().Hex()
+		return nil
+	})
+
+	return blockHash, err
+}
+
+// getDepositLogs 合约收款地址收到的usdt转账，只取调用合约产生的
+// 日志和交易分开请求，每笔交易单独走一次节点池，避免一次回调里堆积大量请求
+func getDepositLogs(ctx context.Context, pool *RpcPool, chainId int64, address string, tokenAddress string, start uint64, end uint64) ([]*userDeposit, error) {
+	var events []*DfilTransfer
+
+	contractAddress := common.HexToAddress(address)
+	err := pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+		events = events[:0]
+
+		instance, err := NewBuySomething(contractAddress, client)
 		if err != nil {
-			fmt.Println(nil, err)
-			continue
+			return err
 		}
 
-		bals, err = instance.GetUsersByIndex(&bind.CallOpts{}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
+		account, err := instance.Account(&bind.CallOpts{Context: ctx})
 		if err != nil {
-			fmt.Println(err)
-			//url1 = "https://bsc-dataseed4.binance.org"
-			continue
+			return err
 		}
 
-		break
-	}
+		token, err := NewDfil(common.HexToAddress(tokenAddress), client)
+		if err != nil {
+			return err
+		}
+
+		iter, err := token.FilterTransfer(&bind.FilterOpts{Start: start, End: &end, Context: ctx}, nil, []common.Address{account})
+		if err != nil {
+			return err
+		}
+		defer iter.Close()
+
+		for iter.Next() {
+			if iter.Event.Raw.Removed {
+				continue
+			}
 
-	for i := 0; i < 5; i++ {
-		if 1 == i {
-			url1 = "https://binance.llamarpc.com/"
-		} else if 2 == i {
-			url1 = "https://bscrpc.com/"
-		} else if 3 == i {
-			url1 = "https://bsc-pokt.nodies.app/"
-		} else if 4 == i {
-			url1 = "https://data-seed-prebsc-1-s3.binance.org:8545/"
+			events = append(events, iter.Event)
 		}
 
-		client, err := ethclient.Dial(url1)
+		return iter.Error()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*userDeposit, 0, len(events))
+	for _, event := range events {
+		var tx *types.Transaction
+		err = pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
+			tx, _, err = client.TransactionByHash(ctx, event.Raw.TxHash)
+			return err
+		})
 		if err != nil {
-			fmt.Println(nil, err)
+			return nil, err
+		}
+
+		if nil == tx.To() || contractAddress != *tx.To() {
 			continue
 		}
 
+		users = append(users, &userDeposit{
+			Address: event.From.String(),
+			Value:   event.Value,
+			Hash:    event.Raw.TxHash.Hex(),
+			Key:     fmt.Sprintf("log:%d:%s:%d", chainId, event.Raw.TxHash.Hex(), event.Raw.Index),
+		})
+	}
+
+	return users, nil
+}
+
+// getUserInfo 地址和金额在同一节点同一区块读取，数量和下标范围不一致时整批失败
+func getUserInfo(ctx context.Context, pool *RpcPool, chainId int64, block uint64, start int64, end int64, address string) ([]*userDeposit, error) {
+	var (
+		bals  []common.Address
+		bals2 []*big.Int
+	)
+	users := make([]*userDeposit, 0)
+
+	err := pool.Do(ctx, func(ctx context.Context, client *ethclient.Client) error {
 		tokenAddress := common.HexToAddress(address)
 		instance, err := NewBuySomething(tokenAddress, client)
 		if err != nil {
-			fmt.Println(nil, err)
-			continue
+			return err
 		}
 
-		bals2, err = instance.GetUsersAmountByIndex(&bind.CallOpts{}, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
+		opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}
+		bals, err = instance.GetUsersByIndex(opts, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
 		if err != nil {
-			fmt.Println(err)
-			//url1 = "https://bsc-dataseed4.binance.org"
-			continue
+			return err
 		}
 
-		break
-	}
+		bals2, err = instance.GetUsersAmountByIndex(opts, new(big.Int).SetInt64(start), new(big.Int).SetInt64(end))
+		if err != nil {
+			return err
+		}
+
+		if int64(len(bals)) != end-start+1 || len(bals) != len(bals2) {
+			return fmt.Errorf("数量不一致，区块%d，下标%d-%d，地址%d，金额%d", block, start, end, len(bals), len(bals2))
+		}
 
-	if len(bals) != len(bals2) {
-		fmt.Println("数量不一致，错误")
-		return users, nil
+		return nil
+	})
+	if err != nil {
+		fmt.Println(err)
+		return nil, err
 	}
 
 	for k, v := range bals {
 		users = append(users, &userDeposit{
 			Address: v.String(),
-			Amount:  bals2[k].Int64(),
+			Value:   bals2[k],
+			Key:     fmt.Sprintf("idx:%d:%s:%d", chainId, common.HexToAddress(address).Hex(), start+int64(k)),
+			Index:   start + int64(k),
 		})
 	}
 
 	return users, nil
 }
 
-func toToken(userPrivateKey string, toAccount string, withdrawAmount string, withdrawTokenAddress string, url1 string) (string, error) {
-	client, err := ethclient.Dial(url1)
-	//client, err := ethclient.Dial("https://bsc-dataseed.binance.org/")
-	if err != nil {
-		return "", err
-	}
-
+// toToken 构造并签名转账交易，不广播
+// toToken 只估算gas和价格，返回未签名交易，签名在节点调用之外进行
+func toToken(ctx context.Context, client *ethclient.Client, from common.Address, nonce uint64, toAccount string, withdrawAmount *big.Int, withdrawTokenAddress string) (*types.Transaction, error) {
 	tokenAddress := common.HexToAddress(withdrawTokenAddress)
 	instance, err := NewDfil(tokenAddress, client)
 	if err != nil {
 		fmt.Println(err)
-		return "", err
-	}
-
-	var authUser *bind.TransactOpts
-
-	var privateKey *ecdsa.PrivateKey
-	privateKey, err = crypto.HexToECDSA(userPrivateKey)
-	if err != nil {
-		fmt.Println(err)
-		return "", err
+		return nil, err
 	}
 
 	//gasPrice, err := client.SuggestGasPrice(context.Background())
 	//if err != nil {
 	//	fmt.Println(err)
-	//	return "", err
+	//	return nil, err
 	//}
 
-	authUser, err = bind.NewKeyedTransactorWithChainID(privateKey, new(big.Int).SetInt64(56))
-	if err != nil {
-		fmt.Println(err)
-		return "", err
-	}
-
-	tmpWithdrawAmount, _ := new(big.Int).SetString(withdrawAmount, 10)
-	_, err = instance.Transfer(&bind.TransactOpts{
-		From:     authUser.From,
-		Signer:   authUser.Signer,
+	tx, err := instance.Transfer(&bind.TransactOpts{
+		From:  from,
+		Nonce: new(big.Int).SetUint64(nonce),
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return tx, nil
+		},
+		Context:  ctx,
 		GasLimit: 0,
-	}, common.HexToAddress(toAccount), tmpWithdrawAmount)
+		NoSend:   true,
+	}, common.HexToAddress(toAccount), withdrawAmount)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return "", nil
+	return tx, nil
 }
@@ -0,0 +1,16 @@
+-- 开卡死信队列
+CREATE TABLE IF NOT EXISTS `card_order` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `order_type` int NOT NULL COMMENT '1虚拟卡持卡人审核，2虚拟卡开卡，3实体卡持卡人审核，4实体卡开卡',
+  `order_no` varchar(100) NOT NULL DEFAULT '',
+  `attempts` int NOT NULL DEFAULT 0,
+  `status` varchar(45) NOT NULL DEFAULT '',
+  `remark` varchar(500) NOT NULL DEFAULT '',
+  `deadline` datetime NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_card_order_user` (`user_id`, `order_type`),
+  KEY `idx_card_order_status` (`status`, `order_type`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,11 @@
+-- 充值扫块进度，每条链一行
+CREATE TABLE IF NOT EXISTS `deposit_checkpoint` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `name` varchar(45) NOT NULL,
+  `block_number` bigint NOT NULL DEFAULT 0,
+  `block_hash` varchar(100) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_deposit_checkpoint_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,11 @@
+-- 充值记录按 deposit_key 去重入账
+-- 老数据没有 deposit_key，先回填 legacy:<id> 再加唯一索引，避免空串冲突
+ALTER TABLE `eth_user_record`
+  ADD COLUMN `chain` varchar(45) NOT NULL DEFAULT '',
+  ADD COLUMN `deposit_key` varchar(200) NULL;
+
+UPDATE `eth_user_record` SET `deposit_key` = CONCAT('legacy:', `id`) WHERE `deposit_key` IS NULL OR `deposit_key` = '';
+
+ALTER TABLE `eth_user_record`
+  MODIFY COLUMN `deposit_key` varchar(200) NOT NULL,
+  ADD UNIQUE KEY `uk_eth_user_record_deposit_key` (`deposit_key`);
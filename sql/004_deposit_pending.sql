@@ -0,0 +1,21 @@
+-- 未注册地址、低于最低金额的充值，待认领或人工处理
+CREATE TABLE IF NOT EXISTS `deposit_pending` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL DEFAULT 0,
+  `address` varchar(100) NOT NULL,
+  `chain` varchar(45) NOT NULL DEFAULT '',
+  `hash` varchar(100) NOT NULL,
+  `deposit_key` varchar(200) NOT NULL,
+  `amount` varchar(100) NOT NULL DEFAULT '',
+  `amount_two` bigint NOT NULL DEFAULT 0,
+  `rel_amount` decimal(65,20) NOT NULL DEFAULT 0,
+  `last` int NOT NULL DEFAULT 0,
+  `status` varchar(45) NOT NULL,
+  `remark` varchar(500) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_deposit_pending_deposit_key` (`deposit_key`),
+  KEY `idx_deposit_pending_status` (`status`, `address`),
+  KEY `idx_deposit_pending_address` (`address`, `status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
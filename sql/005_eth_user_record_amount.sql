@@ -0,0 +1,4 @@
+-- 充值整数金额放宽到bigint，按币种精度换算后的实际到账金额
+ALTER TABLE `eth_user_record`
+  MODIFY COLUMN `amount_two` bigint NOT NULL,
+  ADD COLUMN `rel_amount` decimal(65,20) NOT NULL DEFAULT 0;
@@ -0,0 +1,33 @@
+-- 复式账本：账户、分录、分录行，每笔分录借贷合计为0
+CREATE TABLE IF NOT EXISTS `ledger_account` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL DEFAULT 0,
+  `code` varchar(100) NOT NULL COMMENT 'user:<id> 或 system:<name>',
+  `balance` decimal(65,20) NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_ledger_account_code` (`code`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `ledger_entry` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `reason` int NOT NULL COMMENT '同 reward.reason，0为期初(LedgerReasonOpening)',
+  `ref` varchar(100) NOT NULL DEFAULT '',
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_ledger_entry_reason` (`reason`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS `ledger_posting` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `entry_id` int NOT NULL,
+  `account_id` int NOT NULL,
+  `amount` decimal(65,20) NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_ledger_posting_entry_id` (`entry_id`),
+  KEY `idx_ledger_posting_account_id` (`account_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
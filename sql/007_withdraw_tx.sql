@@ -0,0 +1,11 @@
+-- 提现记录链上发放信息：签名交易、nonce、回执区块，审核备注
+ALTER TABLE `withdraw`
+  ADD COLUMN `chain` varchar(45) NOT NULL DEFAULT '',
+  ADD COLUMN `tx_hash` varchar(100) NOT NULL DEFAULT '',
+  ADD COLUMN `nonce` bigint NOT NULL DEFAULT 0,
+  ADD COLUMN `block_number` bigint NOT NULL DEFAULT 0,
+  ADD COLUMN `raw_tx` text NOT NULL,
+  ADD COLUMN `remark` varchar(500) NOT NULL DEFAULT '',
+  ADD COLUMN `sent_at` datetime NULL,
+  ADD KEY `idx_withdraw_status` (`status`),
+  ADD KEY `idx_withdraw_user` (`user_id`, `created_at`);
@@ -0,0 +1,15 @@
+-- 余额对账差异，每次对账一个 run_id
+CREATE TABLE IF NOT EXISTS `reconcile_discrepancy` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `run_id` bigint NOT NULL,
+  `user_id` int NOT NULL,
+  `address` varchar(100) NOT NULL DEFAULT '',
+  `amount` decimal(65,20) NOT NULL,
+  `expected` decimal(65,20) NOT NULL,
+  `diff` decimal(65,20) NOT NULL,
+  `detail` text,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_reconcile_discrepancy_run_id` (`run_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,6 @@
+-- rewarded 是老的自动通过状态，没有经过审核和风控，迁回待审核，由后台重新审核后发放
+UPDATE `withdraw`
+  SET `status` = 'requested',
+      `remark` = 'rewarded老数据转人工审核',
+      `updated_at` = NOW()
+  WHERE `status` = 'rewarded';